@@ -0,0 +1,292 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/export"
+)
+
+// paging selects the pagination flags a command accepts.
+type paging int
+
+const (
+	withStart paging = 1 << iota
+	withCount
+	withCursor
+)
+
+// command maps a group and command name to a Client method.
+type command struct {
+	group   string
+	name    string
+	summary string
+
+	// args names the positional arguments; a final name ending in "..."
+	// takes one or more values
+	args   []string
+	paging paging
+
+	// params, if set, returns a pointer to a params struct whose fields
+	// become flags
+	params func() any
+
+	// columns are the default table and CSV columns (default: every field of the first result)
+	columns []string
+
+	call func(api linkdapi.API, in *input) (map[string]any, error)
+}
+
+// input is a parsed command line.
+type input struct {
+	args   []string
+	start  int
+	count  int
+	cursor string
+	params any // As returned by command.params, filled from the flags
+}
+
+// arg returns the i-th positional argument.
+func (in *input) arg(i int) string { return in.args[i] }
+
+// commands lists a command per Client method, grouped like the API
+// reference in the README.
+var commands = []command{
+	// Profile
+	{group: "profile", name: "overview", summary: "Basic profile information", args: []string{"username"}, columns: export.ProfileColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetProfileOverview(in.arg(0)) }},
+	{group: "profile", name: "details", summary: "Detailed profile information", args: []string{"urn"}, columns: export.ProfileColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetProfileDetails(in.arg(0)) }},
+	{group: "profile", name: "contact-info", summary: "Email, phone and websites", args: []string{"username"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetContactInfo(in.arg(0)) }},
+	{group: "profile", name: "experience", summary: "Complete work experience", args: []string{"urn"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetFullExperience(in.arg(0)) }},
+	{group: "profile", name: "certifications", summary: "Certifications", args: []string{"urn"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetCertifications(in.arg(0)) }},
+	{group: "profile", name: "education", summary: "Education history", args: []string{"urn"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetEducation(in.arg(0)) }},
+	{group: "profile", name: "skills", summary: "Skills and endorsements", args: []string{"urn"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetSkills(in.arg(0)) }},
+	{group: "profile", name: "social-matrix", summary: "Connections and follower counts", args: []string{"username"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetSocialMatrix(in.arg(0)) }},
+	{group: "profile", name: "recommendations", summary: "Recommendations given and received", args: []string{"urn"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetRecommendations(in.arg(0)) }},
+	{group: "profile", name: "similar", summary: "Similar profiles", args: []string{"urn"}, columns: export.ProfileColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetSimilarProfiles(in.arg(0)) }},
+	{group: "profile", name: "about", summary: "About section and verification info", args: []string{"urn"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetProfileAbout(in.arg(0)) }},
+	{group: "profile", name: "reactions", summary: "Reactions made by a profile", args: []string{"urn"}, paging: withCursor,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetProfileReactions(in.arg(0), in.cursor)
+		}},
+	{group: "profile", name: "interests", summary: "Followed people, companies and groups", args: []string{"urn"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetProfileInterests(in.arg(0)) }},
+	{group: "profile", name: "full", summary: "Full profile in one request", args: []string{"username-or-urn"}, columns: export.ProfileColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			if _, err := linkdapi.ParseProfileURN(in.arg(0)); err == nil {
+				return api.GetFullProfile("", in.arg(0))
+			}
+			return api.GetFullProfile(in.arg(0), "")
+		}},
+	{group: "profile", name: "services", summary: "Services offered", args: []string{"urn"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetProfileServices(in.arg(0)) }},
+	{group: "profile", name: "urn", summary: "Profile URN of a username", args: []string{"username"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetProfileURN(in.arg(0)) }},
+
+	// Company
+	{group: "company", name: "lookup", summary: "Search companies by name", args: []string{"query"}, columns: export.CompanyColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.CompanyNameLookup(in.arg(0)) }},
+	{group: "company", name: "info", summary: "Company information", args: []string{"id-or-name"}, columns: export.CompanyColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			if _, err := linkdapi.ParseCompanyID(in.arg(0)); err == nil {
+				return api.GetCompanyInfo(in.arg(0), "")
+			}
+			return api.GetCompanyInfo("", in.arg(0))
+		}},
+	{group: "company", name: "similar", summary: "Similar companies", args: []string{"company-id"}, columns: export.CompanyColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetSimilarCompanies(in.arg(0)) }},
+	{group: "company", name: "employees", summary: "Employee statistics", args: []string{"company-id"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetCompanyEmployeesData(in.arg(0))
+		}},
+	{group: "company", name: "jobs", summary: "Open jobs of one or more companies", args: []string{"company-id..."}, paging: withStart, columns: export.JobColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetCompanyJobs(in.args, in.start)
+		}},
+	{group: "company", name: "affiliated", summary: "Affiliated pages and subsidiaries", args: []string{"company-id"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetCompanyAffiliatedPages(in.arg(0))
+		}},
+	{group: "company", name: "posts", summary: "Company posts", args: []string{"company-id"}, paging: withStart, columns: export.PostColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetCompanyPosts(in.arg(0), in.start)
+		}},
+	{group: "company", name: "id", summary: "Company ID of a universal name", args: []string{"universal-name"},
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetCompanyID(in.arg(0)) }},
+	{group: "company", name: "details-v2", summary: "Extended company information", args: []string{"company-id"}, columns: export.CompanyColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetCompanyDetailsV2(in.arg(0)) }},
+
+	// Jobs
+	{group: "jobs", name: "search", summary: "Search jobs", params: func() any { return &linkdapi.JobSearchParams{} }, columns: export.JobColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.SearchJobs(*in.params.(*linkdapi.JobSearchParams))
+		}},
+	{group: "jobs", name: "search-v2", summary: "Search jobs with extended filters", params: func() any { return &linkdapi.JobSearchV2Params{} }, columns: export.JobColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.SearchJobsV2(*in.params.(*linkdapi.JobSearchV2Params))
+		}},
+	{group: "jobs", name: "details", summary: "Job details", args: []string{"job-id"}, columns: export.JobColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetJobDetails(in.arg(0)) }},
+	{group: "jobs", name: "similar", summary: "Similar jobs", args: []string{"job-id"}, columns: export.JobColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetSimilarJobs(in.arg(0)) }},
+	{group: "jobs", name: "also-viewed", summary: "Jobs people also viewed", args: []string{"job-id"}, columns: export.JobColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetPeopleAlsoViewedJobs(in.arg(0))
+		}},
+	{group: "jobs", name: "details-v2", summary: "Extended job details", args: []string{"job-id"}, columns: export.JobColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetJobDetailsV2(in.arg(0)) }},
+	{group: "jobs", name: "hiring-team", summary: "Hiring team of a job", args: []string{"job-id"}, paging: withStart, columns: export.ProfileColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetHiringTeam(in.arg(0), in.start)
+		}},
+	{group: "jobs", name: "posted", summary: "Jobs posted by a profile", args: []string{"profile-urn"}, paging: withStart | withCount, columns: export.JobColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetProfilePostedJobs(in.arg(0), in.start, in.count)
+		}},
+
+	// Posts
+	{group: "posts", name: "featured", summary: "Featured posts of a profile", args: []string{"urn"}, columns: export.PostColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetFeaturedPosts(in.arg(0)) }},
+	{group: "posts", name: "all", summary: "All posts of a profile", args: []string{"urn"}, paging: withStart | withCursor, columns: export.PostColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetAllPosts(in.arg(0), in.cursor, in.start)
+		}},
+	{group: "posts", name: "info", summary: "Post details", args: []string{"post-urn"}, columns: export.PostColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) { return api.GetPostInfo(in.arg(0)) }},
+	{group: "posts", name: "comments", summary: "Comments on a post", args: []string{"post-urn"}, paging: withStart | withCount | withCursor, columns: export.CommentColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetPostComments(in.arg(0), in.start, in.count, in.cursor)
+		}},
+	{group: "posts", name: "likes", summary: "People who reacted to a post", args: []string{"post-urn"}, paging: withStart,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetPostLikes(in.arg(0), in.start)
+		}},
+
+	// Comments
+	{group: "comments", name: "all", summary: "Comments made by a profile", args: []string{"urn"}, paging: withCursor, columns: export.CommentColumns,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetAllComments(in.arg(0), in.cursor)
+		}},
+	{group: "comments", name: "likes", summary: "People who reacted to comments", args: []string{"comment-urn..."}, paging: withStart,
+		call: func(api linkdapi.API, in *input) (map[string]any, error) {
+			return api.GetCommentLikes(strings.Join(in.args, ","), in.start)
+		}},
+}
+
+// groups returns the command groups in order of first appearance.
+func groups() []string {
+	var names []string
+	for _, c := range commands {
+		if !hasString(names, c.group) {
+			names = append(names, c.group)
+		}
+	}
+	return names
+}
+
+func hasGroup(group string) bool {
+	return hasString(groups(), group)
+}
+
+func hasString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// argsUsage describes the positional arguments, e.g. "<urn>" or "<company-id>...".
+func (c *command) argsUsage() string {
+	var parts []string
+	for _, a := range c.args {
+		if name, ok := strings.CutSuffix(a, "..."); ok {
+			parts = append(parts, "<"+name+">...")
+		} else {
+			parts = append(parts, "<"+a+">")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// variadic reports whether the last positional argument takes several values.
+func (c *command) variadic() bool {
+	return len(c.args) > 0 && strings.HasSuffix(c.args[len(c.args)-1], "...")
+}
+
+// parse parses the flags and arguments after the command name. Flags may
+// come before or after the positional arguments.
+func (c *command) parse(args []string, g *globals, stderr io.Writer) (*input, error) {
+	in := &input{}
+	fs := flag.NewFlagSet("linkdapi "+c.group+" "+c.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	g.register(fs)
+	if c.paging&withStart != 0 {
+		fs.IntVar(&in.start, "start", 0, "pagination offset (default: API default)")
+	}
+	if c.paging&withCount != 0 {
+		fs.IntVar(&in.count, "count", 0, "results per page (default: API default)")
+	}
+	if c.paging&withCursor != 0 {
+		fs.StringVar(&in.cursor, "cursor", "", "pagination `cursor` from a previous response")
+	}
+	if c.params != nil {
+		in.params = c.params()
+		if err := paramFlags(fs, in.params); err != nil {
+			fmt.Fprintln(stderr, "linkdapi:", err)
+			return nil, err
+		}
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: linkdapi %s %s [flags] %s\n\n%s.\n", c.group, c.name, c.argsUsage(), c.summary)
+		fmt.Fprintln(stderr, "\nFlags:")
+		fs.PrintDefaults()
+	}
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		in.args = append(in.args, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	switch n, want := len(in.args), len(c.args); {
+	case c.variadic() && n >= want, !c.variadic() && n == want:
+	default:
+		fmt.Fprintf(stderr, "linkdapi: %s %s takes %s\n\n", c.group, c.name, describeArgs(c))
+		fs.Usage()
+		return nil, errUsage
+	}
+	for _, a := range in.args {
+		if strings.TrimSpace(a) == "" {
+			fmt.Fprintf(stderr, "linkdapi: %s %s: empty argument\n", c.group, c.name)
+			return nil, errUsage
+		}
+	}
+	return in, nil
+}
+
+func describeArgs(c *command) string {
+	if len(c.args) == 0 {
+		return "no arguments"
+	}
+	return c.argsUsage()
+}
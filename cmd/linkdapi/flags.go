@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// errUnsupportedField is returned by paramFlags for a params field it has no
+// flag for. It is a bug in the command, not a usage error.
+var errUnsupportedField = errors.New("unsupported params field")
+
+// paramFlags defines a flag on fs for each field of the struct params points
+// to, named after the field in kebab case, e.g. WorkplaceTypes becomes
+// -workplace-types. Supported fields are strings, string slices (given
+// comma-separated or by repeating the flag), *int and *bool, including named
+// types such as linkdapi.JobType; other fields fail with errUnsupportedField.
+func paramFlags(fs *flag.FlagSet, params any) error {
+	v := reflect.ValueOf(params).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, value := t.Field(i), v.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := kebab(field.Name)
+		typ := field.Type
+
+		switch {
+		case typ.Kind() == reflect.String:
+			fs.Func(name, usageFor(t, field, ""), func(s string) error {
+				value.SetString(s)
+				return nil
+			})
+
+		case typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.String:
+			fs.Func(name, usageFor(t, field, " from comma-separated `values`"), func(s string) error {
+				for _, part := range strings.Split(s, ",") {
+					if part = strings.TrimSpace(part); part != "" {
+						value.Set(reflect.Append(value, reflect.ValueOf(part).Convert(typ.Elem())))
+					}
+				}
+				return nil
+			})
+
+		case typ.Kind() == reflect.Pointer && typ.Elem().Kind() == reflect.Int:
+			fs.Func(name, usageFor(t, field, " to a `number`"), func(s string) error {
+				n, err := strconv.Atoi(s)
+				if err != nil {
+					return fmt.Errorf("%q is not a number", s)
+				}
+				value.Set(reflect.ValueOf(&n))
+				return nil
+			})
+
+		case typ.Kind() == reflect.Pointer && typ.Elem().Kind() == reflect.Bool:
+			fs.BoolFunc(name, usageFor(t, field, ""), func(s string) error {
+				b, err := strconv.ParseBool(s)
+				if err != nil {
+					return fmt.Errorf("%q is not a boolean", s)
+				}
+				value.Set(reflect.ValueOf(&b))
+				return nil
+			})
+
+		default:
+			return fmt.Errorf("%w %s.%s of type %s", errUnsupportedField, t.Name(), field.Name, typ)
+		}
+	}
+	return nil
+}
+
+// usageFor describes the params field a flag sets, e.g. "sets
+// JobSearchV2Params.SortBy (linkdapi.SortBy)". A back-quoted word in suffix
+// names the flag's argument in -h output.
+func usageFor(params reflect.Type, field reflect.StructField, suffix string) string {
+	usage := "sets " + params.Name() + "." + field.Name
+	typ := field.Type
+	for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	if typ.PkgPath() != "" {
+		usage += " (" + typ.String() + ")"
+	}
+	return usage + suffix
+}
+
+// kebab converts a field name to a flag name, splitting words where a lower
+// case letter or digit is followed by an upper case one: CompanyIDs becomes
+// company-ids and Under10Applicants under10-applicants.
+func kebab(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			b.WriteByte('-')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+)
+
+// TestParamFlags fails when a params struct of a command gains a field
+// paramFlags has no flag for, rather than the command failing at run time.
+func TestParamFlags(t *testing.T) {
+	for _, c := range commands {
+		if c.params == nil {
+			continue
+		}
+		fs := flag.NewFlagSet(c.group+" "+c.name, flag.ContinueOnError)
+		if err := paramFlags(fs, c.params()); err != nil {
+			t.Errorf("%s %s: %v", c.group, c.name, err)
+		}
+	}
+
+	var unsupported struct {
+		Keyword string
+		Radius  float64
+	}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	if err := paramFlags(fs, &unsupported); !errors.Is(err, errUnsupportedField) {
+		t.Errorf("float64 field: err = %v, want errUnsupportedField", err)
+	}
+}
+
+func TestKebab(t *testing.T) {
+	for name, want := range map[string]string{
+		"Keyword":           "keyword",
+		"WorkplaceTypes":    "workplace-types",
+		"CompanyIDs":        "company-ids",
+		"Under10Applicants": "under10-applicants",
+	} {
+		if got := kebab(name); got != want {
+			t.Errorf("kebab(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Command linkdapi queries the LinkdAPI endpoints from the command line.
+//
+// Usage:
+//
+//	linkdapi [flags] <group> <command> [flags] [arguments]
+//
+// For example:
+//
+//	export LINKDAPI_API_KEY=your_api_key
+//	linkdapi profile overview ryanroslansky
+//	linkdapi company info google -o table
+//	linkdapi jobs search-v2 -keyword golang -workplace-types remote -o csv > jobs.csv
+//	linkdapi posts comments 7123456789012345678 -count 50 -o raw
+//
+// Every Client method has a command; run linkdapi without arguments to list
+// them, or "linkdapi <group> <command> -h" for a command's flags. Search
+// commands take one flag per field of their params struct.
+//
+// The API key and other settings are read like linkdapi.ConfigFromEnv, from
+// LINKDAPI_API_KEY and the other LINKDAPI_* variables, or from the JSON file
+// given by -config or LINKDAPI_CONFIG, read like linkdapi.LoadConfig.
+//
+// Output is pretty JSON by default. -o raw prints the response on one line;
+// -o table and -o csv print one row per result, with -columns selecting
+// fields by path as in the export package. The exit status is 1 if the
+// request fails or the API answers with "success": false, 2 on usage errors.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+)
+
+// envConfig names a config file to load when -config is not given.
+const envConfig = "LINKDAPI_CONFIG"
+
+// errUsage is returned for invalid command lines, after the usage was printed.
+var errUsage = errors.New("usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, newClient))
+}
+
+// globals holds the flags accepted both before the group and after the command.
+type globals struct {
+	config  string
+	output  string
+	columns string
+}
+
+func (g *globals) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.config, "config", g.config, "JSON config `file` (default $"+envConfig+")")
+	fs.StringVar(&g.output, "o", g.output, "output `format`: json, raw, table or csv")
+	fs.StringVar(&g.columns, "columns", g.columns, "comma-separated field `paths` for table and csv output")
+}
+
+// run executes the command line args, returning the exit status. newAPI
+// creates the client once the command line is known to be valid.
+func run(args []string, stdout, stderr io.Writer, newAPI func(configPath string) (linkdapi.API, error)) int {
+	g := &globals{output: "json"}
+	root := flag.NewFlagSet("linkdapi", flag.ContinueOnError)
+	root.SetOutput(stderr)
+	g.register(root)
+	root.Usage = func() { usage(stderr, root) }
+	if err := root.Parse(args); err != nil {
+		return exitStatus(err)
+	}
+
+	cmd, rest, err := lookupCommand(root.Args(), stderr, root)
+	if err != nil {
+		return exitStatus(err)
+	}
+	in, err := cmd.parse(rest, g, stderr)
+	if err != nil {
+		return exitStatus(err)
+	}
+	out, err := newOutput(g.output, g.columns, cmd.columns)
+	if err != nil {
+		fmt.Fprintln(stderr, "linkdapi:", err)
+		return 2
+	}
+
+	api, err := newAPI(g.config)
+	if err != nil {
+		fmt.Fprintln(stderr, "linkdapi:", err)
+		return 1
+	}
+	defer api.Close()
+
+	resp, err := cmd.call(api, in)
+	if err != nil {
+		fmt.Fprintln(stderr, "linkdapi:", err)
+		return 1
+	}
+	if err := out.write(stdout, resp); err != nil {
+		fmt.Fprintln(stderr, "linkdapi:", err)
+		return 1
+	}
+	if success, _ := resp["success"].(bool); !success {
+		message, _ := resp["message"].(string)
+		fmt.Fprintf(stderr, "linkdapi: %v: %s\n", linkdapi.ErrUnsuccessful, message)
+		return 1
+	}
+	return 0
+}
+
+// newClient creates a client from the config file at path, or from the
+// environment if path and LINKDAPI_CONFIG are empty.
+func newClient(path string) (linkdapi.API, error) {
+	if path == "" {
+		path = os.Getenv(envConfig)
+	}
+	var config *linkdapi.Config
+	var err error
+	if path != "" {
+		config, err = linkdapi.LoadConfig(path)
+	} else {
+		config, err = linkdapi.ConfigFromEnv()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if config.Credentials == nil && config.Keys == nil {
+		return nil, fmt.Errorf("no API key: set %s or %s, or api_key in a config file",
+			linkdapi.EnvAPIKey, linkdapi.EnvAPIKeyFile)
+	}
+	return linkdapi.NewClientWithConfig("", config), nil
+}
+
+// lookupCommand finds the command named by the first two arguments and
+// returns the arguments after them.
+func lookupCommand(args []string, stderr io.Writer, root *flag.FlagSet) (*command, []string, error) {
+	if len(args) == 0 {
+		root.Usage()
+		return nil, nil, errUsage
+	}
+	group := args[0]
+	if !hasGroup(group) {
+		fmt.Fprintf(stderr, "linkdapi: unknown group %q\n\n", group)
+		root.Usage()
+		return nil, nil, errUsage
+	}
+	if len(args) < 2 || args[1] == "-h" || args[1] == "-help" || args[1] == "--help" {
+		groupUsage(stderr, group)
+		return nil, nil, errUsage
+	}
+	for i := range commands {
+		if commands[i].group == group && commands[i].name == args[1] {
+			return &commands[i], args[2:], nil
+		}
+	}
+	fmt.Fprintf(stderr, "linkdapi: unknown command %q in group %q\n\n", args[1], group)
+	groupUsage(stderr, group)
+	return nil, nil, errUsage
+}
+
+func usage(w io.Writer, root *flag.FlagSet) {
+	fmt.Fprintln(w, "Usage: linkdapi [flags] <group> <command> [flags] [arguments]")
+	fmt.Fprintln(w, "\nGroups:")
+	for _, group := range groups() {
+		var names []string
+		for _, c := range commands {
+			if c.group == group {
+				names = append(names, c.name)
+			}
+		}
+		fmt.Fprintf(w, "  %-9s %s\n", group, strings.Join(names, ", "))
+	}
+	fmt.Fprintln(w, "\nFlags:")
+	root.PrintDefaults()
+	fmt.Fprintf(w, "\nThe API key is read from $%s or $%s.\n", linkdapi.EnvAPIKey, linkdapi.EnvAPIKeyFile)
+}
+
+func groupUsage(w io.Writer, group string) {
+	fmt.Fprintf(w, "Usage: linkdapi %s <command> [flags] [arguments]\n\nCommands:\n", group)
+	for _, c := range commands {
+		if c.group == group {
+			fmt.Fprintf(w, "  %-40s %s\n", c.name+" "+c.argsUsage(), c.summary)
+		}
+	}
+}
+
+// exitStatus maps a parse error to an exit status; -h is not a failure, and
+// a params field without a flag is a bug rather than a usage error.
+func exitStatus(err error) int {
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUnsupportedField):
+		return 1
+	}
+	return 2
+}
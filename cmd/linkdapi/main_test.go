@@ -0,0 +1,242 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapimock"
+)
+
+func ok(data any) linkdapimock.Result {
+	return linkdapimock.Result{Response: map[string]any{"success": true, "statusCode": 200.0, "message": "ok", "data": data}}
+}
+
+func TestRun(t *testing.T) {
+	profile := ok(map[string]any{"fullName": "Ryan Roslansky", "headline": "=CEO at LinkedIn"})
+	jobs := ok(map[string]any{"jobs": []any{
+		map[string]any{"title": "Go Engineer", "companyName": "Google"},
+		map[string]any{"title": "SRE", "companyName": "Microsoft"},
+	}})
+
+	tests := []struct {
+		name   string
+		args   []string
+		script map[string]linkdapimock.Result
+		apiErr error // Returned by newAPI
+
+		want       int
+		wantStdout []string // Substrings of stdout
+		wantStderr []string // Substrings of stderr
+		wantCalls  []linkdapimock.Call
+	}{
+		{
+			name:       "json",
+			args:       []string{"profile", "overview", "ryanroslansky"},
+			script:     map[string]linkdapimock.Result{"GetProfileOverview": profile},
+			wantStdout: []string{"{\n  \"data\": {\n", `"fullName": "Ryan Roslansky"`},
+			wantCalls:  []linkdapimock.Call{{Method: "GetProfileOverview", Args: []any{"ryanroslansky"}}},
+		},
+		{
+			name:       "raw",
+			args:       []string{"-o", "raw", "profile", "overview", "ryanroslansky"},
+			script:     map[string]linkdapimock.Result{"GetProfileOverview": profile},
+			wantStdout: []string{`{"data":{"fullName":"Ryan Roslansky","headline":"=CEO at LinkedIn"},`},
+			wantCalls:  []linkdapimock.Call{{Method: "GetProfileOverview", Args: []any{"ryanroslansky"}}},
+		},
+		{
+			name:       "csv of a single entity",
+			args:       []string{"profile", "overview", "ryanroslansky", "-o", "csv", "-columns", "fullName,headline"},
+			script:     map[string]linkdapimock.Result{"GetProfileOverview": profile},
+			wantStdout: []string{"fullName,headline\nRyan Roslansky,'=CEO at LinkedIn\n"},
+			wantCalls:  []linkdapimock.Call{{Method: "GetProfileOverview", Args: []any{"ryanroslansky"}}},
+		},
+		{
+			name:       "table",
+			args:       []string{"-o", "table", "-columns", "title,companyName", "company", "jobs", "1441", "1035", "-start", "25"},
+			script:     map[string]linkdapimock.Result{"GetCompanyJobs": jobs},
+			wantStdout: []string{"title        companyName\nGo Engineer  Google\nSRE          Microsoft\n"},
+			wantCalls:  []linkdapimock.Call{{Method: "GetCompanyJobs", Args: []any{[]string{"1441", "1035"}, 25}}},
+		},
+		{
+			name:   "company by name",
+			args:   []string{"company", "info", "google"},
+			script: map[string]linkdapimock.Result{"GetCompanyInfo": ok(map[string]any{"name": "Google"})},
+			wantCalls: []linkdapimock.Call{
+				{Method: "GetCompanyInfo", Args: []any{"", "google"}},
+			},
+		},
+		{
+			name:   "company by id",
+			args:   []string{"company", "info", "1441"},
+			script: map[string]linkdapimock.Result{"GetCompanyInfo": ok(map[string]any{"name": "Google"})},
+			wantCalls: []linkdapimock.Call{
+				{Method: "GetCompanyInfo", Args: []any{"1441", ""}},
+			},
+		},
+		{
+			name:   "params flags",
+			args:   []string{"jobs", "search-v2", "-keyword", "golang", "-workplace-types", "remote,hybrid", "-easy-apply", "-count", "10"},
+			script: map[string]linkdapimock.Result{"SearchJobsV2": jobs},
+			wantCalls: []linkdapimock.Call{{Method: "SearchJobsV2", Args: []any{linkdapi.JobSearchV2Params{
+				Keyword:        "golang",
+				WorkplaceTypes: []linkdapi.WorkArrangement{linkdapi.WorkRemote, linkdapi.WorkHybrid},
+				EasyApply:      ptr(true),
+				Count:          ptr(10),
+			}}}},
+		},
+		{
+			name:      "paging flags",
+			args:      []string{"posts", "comments", "-count", "50", "-cursor", "abc", "7123456789012345678"},
+			script:    map[string]linkdapimock.Result{"GetPostComments": ok([]any{})},
+			wantCalls: []linkdapimock.Call{{Method: "GetPostComments", Args: []any{"7123456789012345678", 0, 50, "abc"}}},
+		},
+		{
+			name:       "help",
+			args:       []string{"profile", "overview", "-h"},
+			wantStderr: []string{"username"},
+		},
+
+		{
+			name:       "no arguments",
+			args:       nil,
+			want:       2,
+			wantStderr: []string{"Usage"},
+		},
+		{
+			name:       "unknown group",
+			args:       []string{"people", "overview", "x"},
+			want:       2,
+			wantStderr: []string{`unknown group "people"`},
+		},
+		{
+			name:       "unknown command",
+			args:       []string{"profile", "everything", "x"},
+			want:       2,
+			wantStderr: []string{"everything"},
+		},
+		{
+			name: "missing argument",
+			args: []string{"profile", "overview"},
+			want: 2,
+		},
+		{
+			name: "extra argument",
+			args: []string{"profile", "overview", "a", "b"},
+			want: 2,
+		},
+		{
+			name: "empty argument",
+			args: []string{"profile", "overview", ""},
+			want: 2,
+		},
+		{
+			name:       "unknown flag",
+			args:       []string{"profile", "overview", "-start", "5", "x"},
+			want:       2,
+			wantStderr: []string{"-start"},
+		},
+		{
+			name:       "bad flag value",
+			args:       []string{"posts", "comments", "x", "-count", "abc"},
+			want:       2,
+			wantStderr: []string{"-count"},
+		},
+		{
+			name:       "bad params flag value",
+			args:       []string{"jobs", "search-v2", "-count", "ten"},
+			want:       2,
+			wantStderr: []string{`"ten" is not a number`},
+		},
+		{
+			name:       "unknown output format",
+			args:       []string{"-o", "xml", "profile", "overview", "x"},
+			want:       2,
+			wantStderr: []string{`unknown output format "xml"`},
+		},
+
+		{
+			name:       "client error",
+			args:       []string{"profile", "overview", "x"},
+			apiErr:     errors.New("no API key"),
+			want:       1,
+			wantStderr: []string{"linkdapi: no API key"},
+		},
+		{
+			name:       "request error",
+			args:       []string{"profile", "overview", "x"},
+			script:     map[string]linkdapimock.Result{"GetProfileOverview": {Err: errors.New("connection refused")}},
+			want:       1,
+			wantStderr: []string{"linkdapi: connection refused"},
+			wantCalls:  []linkdapimock.Call{{Method: "GetProfileOverview", Args: []any{"x"}}},
+		},
+		{
+			name: "unsuccessful",
+			args: []string{"profile", "overview", "x"},
+			script: map[string]linkdapimock.Result{"GetProfileOverview": {Response: map[string]any{
+				"success": false, "statusCode": 404.0, "message": "Profile not found", "data": nil,
+			}}},
+			want:       1,
+			wantStdout: []string{`"message": "Profile not found"`},
+			wantStderr: []string{"linkdapi: unsuccessful response: Profile not found"},
+			wantCalls:  []linkdapimock.Call{{Method: "GetProfileOverview", Args: []any{"x"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &linkdapimock.Client{}
+			for method, r := range tt.script {
+				m.On(method, r)
+			}
+			created := false
+			newAPI := func(string) (linkdapi.API, error) {
+				created = true
+				if tt.apiErr != nil {
+					return nil, tt.apiErr
+				}
+				return m, nil
+			}
+
+			var stdout, stderr bytes.Buffer
+			if got := run(tt.args, &stdout, &stderr, newAPI); got != tt.want {
+				t.Fatalf("run() = %d, want %d; stderr:\n%s", got, tt.want, stderr.String())
+			}
+			for _, s := range tt.wantStdout {
+				if !strings.Contains(stdout.String(), s) {
+					t.Errorf("stdout does not contain %q:\n%s", s, stdout.String())
+				}
+			}
+			for _, s := range tt.wantStderr {
+				if !strings.Contains(stderr.String(), s) {
+					t.Errorf("stderr does not contain %q:\n%s", s, stderr.String())
+				}
+			}
+			if tt.want == 2 && created {
+				t.Error("client created for a usage error")
+			}
+
+			var calls []linkdapimock.Call
+			for _, c := range m.Calls() {
+				if c.Method == "Close" {
+					continue
+				}
+				// Drop the trailing options, which the commands never pass
+				calls = append(calls, linkdapimock.Call{Method: c.Method, Args: c.Args[:len(c.Args)-1]})
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("calls = %#v, want %#v", calls, tt.wantCalls)
+			}
+			if closed := len(m.CloseCalls()) > 0; closed != (created && tt.apiErr == nil) {
+				t.Errorf("client closed = %v", closed)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/export"
+)
+
+// output writes a response in one of the -o formats.
+type output struct {
+	format  string
+	columns []string // For table and csv; nil selects every field of the first result
+}
+
+// newOutput validates format and picks the table and CSV columns: those of
+// -columns if given, else the command's defaults.
+func newOutput(format, columns string, defaults []string) (*output, error) {
+	switch format {
+	case "json", "raw", "table", "csv":
+	default:
+		return nil, fmt.Errorf("unknown output format %q (want json, raw, table or csv)", format)
+	}
+	o := &output{format: format, columns: defaults}
+	if columns != "" {
+		o.columns = nil
+		for _, c := range strings.Split(columns, ",") {
+			if c = strings.TrimSpace(c); c != "" {
+				o.columns = append(o.columns, c)
+			}
+		}
+	}
+	return o, nil
+}
+
+func (o *output) write(w io.Writer, resp map[string]any) error {
+	switch o.format {
+	case "raw":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		return enc.Encode(resp)
+	case "csv":
+		return o.writeCSV(w, resp, &export.CSVOptions{Columns: o.columns})
+	case "table":
+		// Render through CSV so tables flatten fields exactly like -o csv
+		var buf bytes.Buffer
+		// Tables are read, not opened in a spreadsheet, so need no formula guard
+		if err := o.writeCSV(&buf, resp, &export.CSVOptions{Columns: o.columns, Comma: '\t', NoFormulaGuard: true}); err != nil {
+			return err
+		}
+		r := csv.NewReader(&buf)
+		r.Comma = '\t'
+		r.FieldsPerRecord = -1
+		rows, err := r.ReadAll()
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, row := range rows {
+			for i, field := range row {
+				row[i] = truncate(strings.Join(strings.Fields(field), " "), 60)
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+// writeCSV writes the records of resp as CSV, or its data as one record if
+// it lists none, as for single-entity commands like "profile overview".
+func (o *output) writeCSV(w io.Writer, resp map[string]any, opts *export.CSVOptions) error {
+	cw := export.NewCSVWriter(w, opts)
+	err := cw.WriteResponse(resp)
+	if errors.Is(err, export.ErrNoRecords) {
+		var record map[string]any
+		if record, err = export.Record(resp); err == nil {
+			err = cw.Write(record)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return cw.Flush()
+}
+
+// truncate shortens s to at most n runes for table cells.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,245 @@
+// Command mockgen generates the linkdapimock package from the API interface
+// declared in linkdapi/api.go.
+//
+// Usage (from the linkdapi/linkdapimock directory):
+//
+//	go run ../../internal/mockgen -src ../api.go -out mock.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	sdkPackage = "linkdapi"
+	sdkImport  = "github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	rootType   = "API"
+)
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+func main() {
+	src := flag.String("src", "../api.go", "file declaring the API interface")
+	out := flag.String("out", "mock.go", "output file")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, *src, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	interfaces := make(map[string]*ast.InterfaceType)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.TypeSpec); ok {
+			if it, ok := spec.Type.(*ast.InterfaceType); ok {
+				interfaces[spec.Name.Name] = it
+			}
+		}
+		return true
+	})
+	if interfaces[rootType] == nil {
+		log.Fatalf("%s: interface %s not found", *src, rootType)
+	}
+
+	g := &generator{imports: map[string]bool{"sync": true, sdkImport: true}}
+	methods := g.collect(interfaces, rootType)
+
+	code, err := format.Source(g.render(methods))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	imports map[string]bool
+}
+
+// collect returns the methods of the named interface, expanding embedded
+// interfaces in declaration order.
+func (g *generator) collect(interfaces map[string]*ast.InterfaceType, name string) []method {
+	var methods []method
+	for _, field := range interfaces[name].Methods.List {
+		switch t := field.Type.(type) {
+		case *ast.Ident:
+			methods = append(methods, g.collect(interfaces, t.Name)...)
+		case *ast.FuncType:
+			m := method{name: field.Names[0].Name}
+			for _, p := range fieldList(t.Params) {
+				typ := p.Type
+				variadic := false
+				if e, ok := typ.(*ast.Ellipsis); ok {
+					typ, variadic = e.Elt, true
+				}
+				names := p.Names
+				if len(names) == 0 {
+					names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", len(m.params)))}
+				}
+				for _, n := range names {
+					m.params = append(m.params, param{name: n.Name, typ: g.typeString(typ), variadic: variadic})
+				}
+			}
+			for _, r := range fieldList(t.Results) {
+				for range max(1, len(r.Names)) {
+					m.results = append(m.results, g.typeString(r.Type))
+				}
+			}
+			methods = append(methods, m)
+		default:
+			log.Fatalf("unsupported interface element in %s", name)
+		}
+	}
+	return methods
+}
+
+func fieldList(fl *ast.FieldList) []*ast.Field {
+	if fl == nil {
+		return nil
+	}
+	return fl.List
+}
+
+// typeString renders a type expression, qualifying identifiers declared in
+// the SDK package.
+func (g *generator) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return sdkPackage + "." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + g.typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + g.typeString(t.Key) + "]" + g.typeString(t.Value)
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		g.imports[pkg] = true
+		return pkg + "." + t.Sel.Name
+	case *ast.FuncType:
+		var params []string
+		for _, p := range fieldList(t.Params) {
+			params = append(params, g.typeString(p.Type))
+		}
+		return "func(" + strings.Join(params, ", ") + ")"
+	case *ast.Ellipsis:
+		return "..." + g.typeString(t.Elt)
+	}
+	log.Fatalf("unsupported type expression %T", expr)
+	return ""
+}
+
+func (g *generator) render(methods []method) []byte {
+	var b bytes.Buffer
+	w := func(format string, args ...any) { fmt.Fprintf(&b, format, args...) }
+
+	w("// Code generated by internal/mockgen from linkdapi/api.go. DO NOT EDIT.\n\n")
+	w("package linkdapimock\n\nimport (\n")
+	var std, other []string
+	for imp := range g.imports {
+		if strings.Contains(imp, ".") {
+			other = append(other, imp)
+		} else {
+			std = append(std, imp)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	for _, imp := range std {
+		w("\t%q\n", imp)
+	}
+	w("\n")
+	for _, imp := range other {
+		w("\t%q\n", imp)
+	}
+	w(")\n\n")
+
+	w("// Ensure that Client implements linkdapi.API.\nvar _ %s.%s = (*Client)(nil)\n\n", sdkPackage, rootType)
+
+	w("// Client is a mock implementation of linkdapi.API.\n//\n")
+	w("// Each method records its call, then delegates to the matching Func field if\n")
+	w("// set, and otherwise returns the next response scripted with On.\n")
+	w("type Client struct {\n")
+	for _, m := range methods {
+		w("\t// %sFunc mocks the %s method.\n", m.name, m.name)
+		w("\t%sFunc func(%s)", m.name, signature(m.params))
+		if len(m.results) > 0 {
+			w(" (%s)", strings.Join(m.results, ", "))
+		}
+		w("\n\n")
+	}
+	w("\tmu      sync.Mutex\n\tcalls   []Call\n\tscripts map[string][]Result\n}\n\n")
+
+	for _, m := range methods {
+		names := make([]string, len(m.params))
+		for i, p := range m.params {
+			names[i] = p.name
+		}
+		call := strings.Join(names, ", ")
+		if len(m.params) > 0 && m.params[len(m.params)-1].variadic {
+			call += "..."
+		}
+
+		w("// %s calls %sFunc or returns the next scripted response.\n", m.name, m.name)
+		w("func (m *Client) %s(%s)", m.name, signature(m.params))
+		switch {
+		case len(m.results) == 0:
+			w(" {\n\tm.record(%q, %s)\n", m.name, argList(names))
+			w("\tif m.%sFunc != nil {\n\t\tm.%sFunc(%s)\n\t}\n}\n\n", m.name, m.name, call)
+		case strings.Join(m.results, ", ") == "map[string]any, error":
+			w(" (%s) {\n\tm.record(%q, %s)\n", strings.Join(m.results, ", "), m.name, argList(names))
+			w("\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", m.name, m.name, call)
+			w("\treturn m.next(%q)\n}\n\n", m.name)
+		default:
+			log.Fatalf("method %s: unsupported results %v", m.name, m.results)
+		}
+
+		w("// %sCalls returns the recorded calls to %s.\n", m.name, m.name)
+		w("func (m *Client) %sCalls() []Call {\n\treturn m.CallsTo(%q)\n}\n\n", m.name, m.name)
+	}
+	return b.Bytes()
+}
+
+func signature(params []param) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		typ := p.typ
+		if p.variadic {
+			typ = "..." + typ
+		}
+		parts[i] = p.name + " " + typ
+	}
+	return strings.Join(parts, ", ")
+}
+
+func argList(names []string) string {
+	if len(names) == 0 {
+		return "nil"
+	}
+	return "[]any{" + strings.Join(names, ", ") + "}"
+}
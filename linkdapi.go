@@ -16,10 +16,18 @@
 package linkdapi
 
 import (
+    "context"
+
     "github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
 )
 
 type Client = linkdapi.Client
+type API = linkdapi.API
+type ProfileAPI = linkdapi.ProfileAPI
+type CompanyAPI = linkdapi.CompanyAPI
+type JobAPI = linkdapi.JobAPI
+type PostAPI = linkdapi.PostAPI
+type CommentAPI = linkdapi.CommentAPI
 type Config = linkdapi.Config
 type JobSearchParams = linkdapi.JobSearchParams
 type JobSearchV2Params = linkdapi.JobSearchV2Params
@@ -27,9 +35,111 @@ type PeopleSearchParams = linkdapi.PeopleSearchParams
 type CompanySearchParams = linkdapi.CompanySearchParams
 type ServiceSearchParams = linkdapi.ServiceSearchParams
 type PostSearchParams = linkdapi.PostSearchParams
+type ProfileURN = linkdapi.ProfileURN
+type PostURN = linkdapi.PostURN
+type CommentURN = linkdapi.CommentURN
+type CompanyID = linkdapi.CompanyID
+type JobID = linkdapi.JobID
+type Username = linkdapi.Username
+type LinkedInURL = linkdapi.LinkedInURL
+type URLKind = linkdapi.URLKind
+type Resolver = linkdapi.Resolver
+type JobType = linkdapi.JobType
+type ExperienceLevel = linkdapi.ExperienceLevel
+type TimePosted = linkdapi.TimePosted
+type Salary = linkdapi.Salary
+type WorkArrangement = linkdapi.WorkArrangement
+type Benefit = linkdapi.Benefit
+type Commitment = linkdapi.Commitment
+type SortBy = linkdapi.SortBy
+type PostContentType = linkdapi.PostContentType
+type PostDatePosted = linkdapi.PostDatePosted
+type CompanySize = linkdapi.CompanySize
+type ValidationError = linkdapi.ValidationError
+type FieldError = linkdapi.FieldError
+type RequestOption = linkdapi.RequestOption
+type CredentialsProvider = linkdapi.CredentialsProvider
+type EnvCredentials = linkdapi.EnvCredentials
+type FileCredentials = linkdapi.FileCredentials
+type KeyProvider = linkdapi.KeyProvider
+type KeyPool = linkdapi.KeyPool
+type KeyPoolOptions = linkdapi.KeyPoolOptions
+type KeySelection = linkdapi.KeySelection
+type KeyStats = linkdapi.KeyStats
+type CooldownError = linkdapi.CooldownError
+type Usage = linkdapi.Usage
+type BatchOptions = linkdapi.BatchOptions
+type ProfileSection = linkdapi.ProfileSection
+type AssembledProfile = linkdapi.AssembledProfile
+type CompanySection = linkdapi.CompanySection
+type CompanyReport = linkdapi.CompanyReport
+type EndpointUsage = linkdapi.EndpointUsage
+
+const (
+    RoundRobin = linkdapi.RoundRobin
+    LeastUsed = linkdapi.LeastUsed
+    SectionOverview = linkdapi.SectionOverview
+    SectionDetails = linkdapi.SectionDetails
+    SectionExperience = linkdapi.SectionExperience
+    SectionEducation = linkdapi.SectionEducation
+    SectionSkills = linkdapi.SectionSkills
+    SectionCertifications = linkdapi.SectionCertifications
+    SectionRecommendations = linkdapi.SectionRecommendations
+    SectionInterests = linkdapi.SectionInterests
+    SectionContactInfo = linkdapi.SectionContactInfo
+    CompanySectionInfo = linkdapi.CompanySectionInfo
+    CompanySectionDetails = linkdapi.CompanySectionDetails
+    CompanySectionEmployees = linkdapi.CompanySectionEmployees
+    CompanySectionJobs = linkdapi.CompanySectionJobs
+    CompanySectionSimilar = linkdapi.CompanySectionSimilar
+    CompanySectionAffiliatedPages = linkdapi.CompanySectionAffiliatedPages
+    CompanySectionPosts = linkdapi.CompanySectionPosts
+    EnvAPIKey = linkdapi.EnvAPIKey
+    EnvAPIKeyFile = linkdapi.EnvAPIKeyFile
+    EnvBaseURL = linkdapi.EnvBaseURL
+    EnvTimeout = linkdapi.EnvTimeout
+    EnvMaxRetries = linkdapi.EnvMaxRetries
+    EnvRetryDelay = linkdapi.EnvRetryDelay
+    EnvRateLimit = linkdapi.EnvRateLimit
+    EnvBudget = linkdapi.EnvBudget
+    EnvResolverCacheFile = linkdapi.EnvResolverCacheFile
+)
 
 var (
     NewClient = linkdapi.NewClient
     NewClientWithConfig = linkdapi.NewClientWithConfig
     DefaultConfig = linkdapi.DefaultConfig
+    ConfigFromEnv = linkdapi.ConfigFromEnv
+    LoadConfig = linkdapi.LoadConfig
+    ErrInvalidConfig = linkdapi.ErrInvalidConfig
+    ErrBudgetExceeded = linkdapi.ErrBudgetExceeded
+    ErrBatchSkipped = linkdapi.ErrBatchSkipped
+    ProfileSections = linkdapi.ProfileSections
+    CompanySections = linkdapi.CompanySections
+    Int = linkdapi.Int
+    WithStart = linkdapi.WithStart
+    WithCount = linkdapi.WithCount
+    WithCursor = linkdapi.WithCursor
+    WithTimeout = linkdapi.WithTimeout
+    WithContext = linkdapi.WithContext
+    WithNoCache = linkdapi.WithNoCache
+    WithHeader = linkdapi.WithHeader
+    NewFileCredentials = linkdapi.NewFileCredentials
+    NewKeyPool = linkdapi.NewKeyPool
+    ErrNoKeys = linkdapi.ErrNoKeys
+    ParseProfileURN = linkdapi.ParseProfileURN
+    ParsePostURN = linkdapi.ParsePostURN
+    ParseCommentURN = linkdapi.ParseCommentURN
+    ParseCompanyID = linkdapi.ParseCompanyID
+    ParseJobID = linkdapi.ParseJobID
+    ParseUsername = linkdapi.ParseUsername
+    ErrInvalidIdentifier = linkdapi.ErrInvalidIdentifier
+    ParseLinkedInURL = linkdapi.ParseLinkedInURL
+    ErrInvalidURL = linkdapi.ErrInvalidURL
+    ErrInvalidParams = linkdapi.ErrInvalidParams
 )
+
+// Batch runs fn over items using a bounded number of workers; see linkdapi.Batch.
+func Batch[T, R any](ctx context.Context, items []T, fn func(T) (R, error), options *BatchOptions) ([]linkdapi.BatchResult[T, R], error) {
+    return linkdapi.Batch(ctx, items, fn, options)
+}
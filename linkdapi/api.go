@@ -0,0 +1,78 @@
+package linkdapi
+
+// Interfaces grouping the Client methods by domain. Depend on the narrowest
+// interface you need so that tests can substitute a fake, such as the one in
+// the linkdapimock package.
+
+// ProfileAPI is the set of profile endpoints.
+type ProfileAPI interface {
+	GetProfileOverview(username string, opts ...RequestOption) (map[string]any, error)
+	GetProfileDetails(urn string, opts ...RequestOption) (map[string]any, error)
+	GetContactInfo(username string, opts ...RequestOption) (map[string]any, error)
+	GetFullExperience(urn string, opts ...RequestOption) (map[string]any, error)
+	GetCertifications(urn string, opts ...RequestOption) (map[string]any, error)
+	GetEducation(urn string, opts ...RequestOption) (map[string]any, error)
+	GetSkills(urn string, opts ...RequestOption) (map[string]any, error)
+	GetSocialMatrix(username string, opts ...RequestOption) (map[string]any, error)
+	GetRecommendations(urn string, opts ...RequestOption) (map[string]any, error)
+	GetSimilarProfiles(urn string, opts ...RequestOption) (map[string]any, error)
+	GetProfileAbout(urn string, opts ...RequestOption) (map[string]any, error)
+	GetProfileReactions(urn string, cursor string, opts ...RequestOption) (map[string]any, error)
+	GetProfileInterests(urn string, opts ...RequestOption) (map[string]any, error)
+	GetFullProfile(username, urn string, opts ...RequestOption) (map[string]any, error)
+	GetProfileServices(urn string, opts ...RequestOption) (map[string]any, error)
+	GetProfileURN(username string, opts ...RequestOption) (map[string]any, error)
+}
+
+// CompanyAPI is the set of company endpoints.
+type CompanyAPI interface {
+	CompanyNameLookup(query string, opts ...RequestOption) (map[string]any, error)
+	GetCompanyInfo(companyID, name string, opts ...RequestOption) (map[string]any, error)
+	GetSimilarCompanies(companyID string, opts ...RequestOption) (map[string]any, error)
+	GetCompanyEmployeesData(companyID string, opts ...RequestOption) (map[string]any, error)
+	GetCompanyJobs(companyIDs []string, start int, opts ...RequestOption) (map[string]any, error)
+	GetCompanyAffiliatedPages(companyID string, opts ...RequestOption) (map[string]any, error)
+	GetCompanyPosts(companyID string, start int, opts ...RequestOption) (map[string]any, error)
+	GetCompanyID(universalName string, opts ...RequestOption) (map[string]any, error)
+	GetCompanyDetailsV2(companyID string, opts ...RequestOption) (map[string]any, error)
+}
+
+// JobAPI is the set of job endpoints.
+type JobAPI interface {
+	SearchJobs(searchParams JobSearchParams, opts ...RequestOption) (map[string]any, error)
+	GetJobDetails(jobID string, opts ...RequestOption) (map[string]any, error)
+	GetSimilarJobs(jobID string, opts ...RequestOption) (map[string]any, error)
+	GetPeopleAlsoViewedJobs(jobID string, opts ...RequestOption) (map[string]any, error)
+	GetJobDetailsV2(jobID string, opts ...RequestOption) (map[string]any, error)
+	SearchJobsV2(searchParams JobSearchV2Params, opts ...RequestOption) (map[string]any, error)
+	GetHiringTeam(jobID string, start int, opts ...RequestOption) (map[string]any, error)
+	GetProfilePostedJobs(profileUrn string, start, count int, opts ...RequestOption) (map[string]any, error)
+}
+
+// PostAPI is the set of post endpoints.
+type PostAPI interface {
+	GetFeaturedPosts(urn string, opts ...RequestOption) (map[string]any, error)
+	GetAllPosts(urn string, cursor string, start int, opts ...RequestOption) (map[string]any, error)
+	GetPostInfo(urn string, opts ...RequestOption) (map[string]any, error)
+	GetPostComments(urn string, start int, count int, cursor string, opts ...RequestOption) (map[string]any, error)
+	GetPostLikes(urn string, start int, opts ...RequestOption) (map[string]any, error)
+}
+
+// CommentAPI is the set of comment endpoints.
+type CommentAPI interface {
+	GetAllComments(urn string, cursor string, opts ...RequestOption) (map[string]any, error)
+	GetCommentLikes(urns string, start int, opts ...RequestOption) (map[string]any, error)
+}
+
+// API is the full set of endpoints implemented by Client.
+type API interface {
+	ProfileAPI
+	CompanyAPI
+	JobAPI
+	PostAPI
+	CommentAPI
+
+	Close()
+}
+
+var _ API = (*Client)(nil)
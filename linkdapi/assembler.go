@@ -0,0 +1,308 @@
+package linkdapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ProfileSection names a part of a profile fetched by AssembleProfile.
+type ProfileSection string
+
+const (
+	SectionOverview        ProfileSection = "overview"        // GetProfileOverview
+	SectionDetails         ProfileSection = "details"         // GetProfileDetails
+	SectionExperience      ProfileSection = "experience"      // GetFullExperience
+	SectionEducation       ProfileSection = "education"       // GetEducation
+	SectionSkills          ProfileSection = "skills"          // GetSkills
+	SectionCertifications  ProfileSection = "certifications"  // GetCertifications
+	SectionRecommendations ProfileSection = "recommendations" // GetRecommendations
+	SectionInterests       ProfileSection = "interests"       // GetProfileInterests
+	SectionContactInfo     ProfileSection = "contact_info"    // GetContactInfo
+)
+
+// ProfileSections lists every section, the default for AssembleProfile.
+var ProfileSections = []ProfileSection{
+	SectionOverview, SectionDetails, SectionExperience, SectionEducation, SectionSkills,
+	SectionCertifications, SectionRecommendations, SectionInterests, SectionContactInfo,
+}
+
+// AssembledProfile is a profile stitched together from section endpoints.
+// Each section field is nil if the section was not requested or failed.
+//
+// Object sections hold the "data" object of their endpoint's response. List
+// sections hold the items of the response's list: the "data" list itself, or
+// the list a "data" object holds under the section's name (e.g.
+// "experience") or as its only list; an object without a list yields no
+// items. Skills given as plain strings become {"name": skill}. A response
+// of another shape fails the section.
+type AssembledProfile struct {
+	Username Username   // Empty if only a URN was given and its username is unknown
+	URN      ProfileURN // Empty if the username could not be resolved
+
+	Overview        map[string]any   // Object, e.g. {"fullName": ..., "headline": ...}
+	Details         map[string]any   // Object
+	Experience      []map[string]any // List of positions, e.g. {"title": ..., "companyName": ...}
+	Education       []map[string]any // List of schools
+	Skills          []map[string]any // List of skills, e.g. {"name": ...}
+	Certifications  []map[string]any // List of certifications, e.g. {"name": ..., "authority": ...}
+	Recommendations map[string]any   // Object
+	Interests       map[string]any   // Object
+	ContactInfo     map[string]any   // Object
+
+	Failed map[ProfileSection]error // Sections that could not be fetched and why
+}
+
+// Err joins the errors of the failed sections, or returns nil if none failed.
+func (p *AssembledProfile) Err() error {
+	return sectionErrors(p.Failed)
+}
+
+// AssembleProfile fetches the given sections of a profile concurrently, from
+// a username or profile URN, and merges them into one AssembledProfile. All
+// sections are fetched if none are given. opts apply to every section call
+// and the username lookup, and WithTimeout bounds them all together rather
+// than each call; pass WithContext to cancel the calls in flight.
+//
+// A failing section is recorded in Failed and does not fail the result; the
+// error is only non-nil if usernameOrURN is neither a username nor a URN.
+// The overview and contact info sections need a username, so they fail when
+// given a URN the client has not resolved before.
+//
+// Example:
+//
+//	profile, err := client.AssembleProfile("ryanroslansky",
+//	    []linkdapi.ProfileSection{linkdapi.SectionOverview, linkdapi.SectionSkills})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := profile.Err(); err != nil {
+//	    log.Printf("partial profile: %v", err)
+//	}
+//
+// Use GetFullProfile instead when one request returns everything you need.
+func (c *Client) AssembleProfile(usernameOrURN string, sections []ProfileSection, opts ...RequestOption) (*AssembledProfile, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(sections) == 0 {
+		sections = ProfileSections
+	}
+	var v validator
+	eachOneOf(&v, "sections", sections, ProfileSections)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	byUsername := map[ProfileSection]func(string, ...RequestOption) (map[string]any, error){
+		SectionOverview:    c.GetProfileOverview,
+		SectionContactInfo: c.GetContactInfo,
+	}
+	byURN := map[ProfileSection]func(string, ...RequestOption) (map[string]any, error){
+		SectionDetails:         c.GetProfileDetails,
+		SectionExperience:      c.GetFullExperience,
+		SectionEducation:       c.GetEducation,
+		SectionSkills:          c.GetSkills,
+		SectionCertifications:  c.GetCertifications,
+		SectionRecommendations: c.GetRecommendations,
+		SectionInterests:       c.GetProfileInterests,
+	}
+
+	// Resolve the URN once rather than once per section
+	needURN := false
+	for _, s := range sections {
+		needURN = needURN || byURN[s] != nil
+	}
+
+	p := &AssembledProfile{}
+	var usernameErr, urnErr error
+	if urn, err := ParseProfileURN(usernameOrURN); err == nil {
+		p.URN = urn
+	} else if p.Username, err = ParseUsername(usernameOrURN); err != nil {
+		return nil, fmt.Errorf("%w: %q is neither a profile URN nor a username", ErrInvalidIdentifier, usernameOrURN)
+	} else if needURN {
+		p.URN, urnErr = c.resolver.profileURN(o, usernameOrURN)
+	}
+	if p.Username == "" {
+		p.Username, usernameErr = c.resolver.Username(p.URN.String())
+	}
+
+	ctx, cancel := c.callContext(o)
+	defer cancel()
+
+	// Share the deadline fixed above, rather than restart WithTimeout per section
+	sectionOpts := o.lookup()
+	var data map[ProfileSection]any
+	data, p.Failed = fetchSections(ctx, sections, func(s ProfileSection) (map[string]any, error) {
+		if get, ok := byUsername[s]; ok {
+			if usernameErr != nil {
+				return nil, usernameErr
+			}
+			return get(p.Username.String(), sectionOpts...)
+		}
+		if urnErr != nil {
+			return nil, urnErr
+		}
+		return byURN[s](p.URN.String(), sectionOpts...)
+	})
+	for s, d := range data {
+		var err error
+		switch s {
+		case SectionOverview:
+			p.Overview, err = sectionObject(d)
+		case SectionDetails:
+			p.Details, err = sectionObject(d)
+		case SectionExperience:
+			p.Experience, err = sectionList(d, "experience", "positions")
+		case SectionEducation:
+			p.Education, err = sectionList(d, "education", "educations")
+		case SectionSkills:
+			p.Skills, err = sectionList(d, "skills")
+		case SectionCertifications:
+			p.Certifications, err = sectionList(d, "certifications", "certificates")
+		case SectionRecommendations:
+			p.Recommendations, err = sectionObject(d)
+		case SectionInterests:
+			p.Interests, err = sectionObject(d)
+		case SectionContactInfo:
+			p.ContactInfo, err = sectionObject(d)
+		}
+		if err != nil {
+			p.Failed[s] = err
+		}
+	}
+	return p, nil
+}
+
+// sectionObject returns the "data" of a section response that should be an
+// object.
+func sectionObject(data any) (map[string]any, error) {
+	m, ok := data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected data: got %s, want an object", jsonKind(data))
+	}
+	return m, nil
+}
+
+// sectionList returns the items of a section response that should hold a
+// list: data itself, or the first of keys holding a list in data, or the
+// only list in data. An object without a list has no items. String items,
+// such as plain skill names, become {"name": item}.
+func sectionList(data any, keys ...string) ([]map[string]any, error) {
+	var list []any
+	switch data := data.(type) {
+	case []any:
+		list = data
+	case map[string]any:
+		for _, key := range keys {
+			if l, ok := data[key].([]any); ok {
+				list = l
+				break
+			}
+		}
+		if list == nil {
+			var lists [][]any
+			for _, v := range data {
+				if l, ok := v.([]any); ok {
+					lists = append(lists, l)
+				}
+			}
+			if len(lists) > 1 {
+				return nil, fmt.Errorf("unexpected data: object holds %d lists, want one", len(lists))
+			}
+			if len(lists) == 1 {
+				list = lists[0]
+			}
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("unexpected data: got %s, want a list", jsonKind(data))
+	}
+
+	items := make([]map[string]any, 0, len(list))
+	for _, item := range list {
+		switch item := item.(type) {
+		case map[string]any:
+			items = append(items, item)
+		case string:
+			items = append(items, map[string]any{"name": item})
+		default:
+			return nil, fmt.Errorf("unexpected data: list item is %s, want an object", jsonKind(item))
+		}
+	}
+	return items, nil
+}
+
+// jsonKind names the JSON type of a decoded value.
+func jsonKind(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "a list"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// fetchSections calls fetch for each distinct section concurrently and
+// returns the "data" of each successful response, and the error of each
+// section that failed or answered with an unsuccessful envelope.
+func fetchSections[S ~string](ctx context.Context, sections []S, fetch func(S) (map[string]any, error)) (map[S]any, map[S]error) {
+	var unique []S
+	seen := make(map[S]bool)
+	for _, s := range sections {
+		if !seen[s] {
+			seen[s] = true
+			unique = append(unique, s)
+		}
+	}
+
+	results, _ := Batch(ctx, unique, func(s S) (any, error) {
+		resp, err := fetch(s)
+		if err != nil {
+			return nil, err
+		}
+		if success, _ := resp["success"].(bool); !success {
+			message, _ := resp["message"].(string)
+			return nil, fmt.Errorf("%w: %s", ErrUnsuccessful, message)
+		}
+		return resp["data"], nil
+	}, &BatchOptions{Workers: len(unique)})
+
+	data := make(map[S]any)
+	failed := make(map[S]error)
+	for _, r := range results {
+		if r.Err != nil {
+			failed[r.Item] = r.Err
+		} else {
+			data[r.Item] = r.Value
+		}
+	}
+	return data, failed
+}
+
+// sectionErrors joins the errors of failed sections, prefixed with the
+// section name and sorted by it, or returns nil if none failed.
+func sectionErrors[S ~string](failed map[S]error) error {
+	sections := make([]string, 0, len(failed))
+	for s := range failed {
+		sections = append(sections, string(s))
+	}
+	sort.Strings(sections)
+
+	errs := make([]error, len(sections))
+	for i, s := range sections {
+		errs[i] = fmt.Errorf("%s: %w", s, failed[S(s)])
+	}
+	return errors.Join(errs...)
+}
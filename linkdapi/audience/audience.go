@@ -0,0 +1,506 @@
+// Package audience collects the people who engaged with a set of posts:
+// those who reacted to them, commented on them, and optionally reacted to
+// their comments.
+//
+// Basic usage:
+//
+//	engagers, err := audience.Extract(client, []string{
+//	    "7123456789012345678",
+//	    "urn:li:activity:7123456789012345679",
+//	}, &audience.Options{CommentLikes: true, Enrich: true})
+//	if err != nil {
+//	    log.Printf("partial audience: %v", err)
+//	}
+//	for _, e := range engagers {
+//	    fmt.Println(e.Name, e.Counts[audience.Comment], e.Total())
+//	}
+//
+// Each person appears once, however many times and on however many posts
+// they engaged.
+package audience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/internal/apiutil"
+)
+
+// API is the part of the LinkdAPI client the extractor uses. *linkdapi.Client
+// and the linkdapimock.Client implement it.
+type API interface {
+	linkdapi.ProfileAPI
+	linkdapi.PostAPI
+	linkdapi.CommentAPI
+}
+
+// Engagement is a way of engaging with a post.
+type Engagement string
+
+const (
+	Like        Engagement = "like"         // Reacted to the post (GetPostLikes)
+	Comment     Engagement = "comment"      // Commented on the post (GetPostComments)
+	CommentLike Engagement = "comment_like" // Reacted to a comment on the post (GetCommentLikes)
+)
+
+// Engager is a person who engaged with one or more of the posts.
+type Engager struct {
+	URN       string             `json:"urn,omitempty"`      // Profile URN, if known
+	Username  string             `json:"username,omitempty"` // Public identifier, if known
+	Name      string             `json:"name,omitempty"`
+	Headline  string             `json:"headline,omitempty"`
+	Counts    map[Engagement]int `json:"counts"`              // Engagements by type
+	Reactions map[string]int     `json:"reactions,omitempty"` // Reactions by type, e.g. "LIKE" or "PRAISE"
+	Posts     []string           `json:"posts"`               // URNs of the posts engaged with, in input order
+	Profile   map[string]any     `json:"profile,omitempty"`   // Profile data, if Options.Enrich is set
+}
+
+// Total returns the number of engagements of every type.
+func (e Engager) Total() int {
+	n := 0
+	for _, c := range e.Counts {
+		n += c
+	}
+	return n
+}
+
+// Key returns the engager's identity: the profile URN, or the username when
+// the URN is unknown.
+func (e Engager) Key() string {
+	if e.URN != "" {
+		return e.URN
+	}
+	return "username:" + e.Username
+}
+
+// Options configures an extraction.
+type Options struct {
+	// MaxPages is the number of pages fetched per post and endpoint (default: 10)
+	MaxPages int
+
+	// CommentLikes also collects the people who reacted to the posts'
+	// comments, at the cost of extra requests (default: false)
+	CommentLikes bool
+
+	// Enrich fetches each engager's profile, with GetProfileOverview when the
+	// username is known and GetProfileDetails otherwise (default: false)
+	Enrich bool
+
+	// MinEngagements drops engagers with fewer engagements in total, before
+	// enriching (default: 0, keep everyone)
+	MinEngagements int
+
+	// Workers is the number of posts, or engagers when enriching, fetched in parallel (default: 4)
+	Workers int
+
+	// Context, if set, stops the extraction when done, aborting requests in
+	// flight; the engagers found so far are returned
+	Context context.Context
+}
+
+// commentsPageSize is the count requested from GetPostComments, its maximum.
+const commentsPageSize = 50
+
+// commentLikesBatch is the number of comment URNs sent per GetCommentLikes call.
+const commentLikesBatch = 10
+
+// Extract collects the engagers of posts, given as URNs in any form accepted
+// by linkdapi.ParsePostURN. Engagers are ordered by total engagements, most
+// first. Posts or profiles that fail are reported in the joined error; the
+// engagers found otherwise are still returned.
+func Extract(api API, posts []string, opts *Options) ([]Engager, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	x := extractor{api: api, opts: *opts, ctx: opts.Context}
+	if x.opts.MaxPages <= 0 {
+		x.opts.MaxPages = 10
+	}
+	if x.ctx == nil {
+		x.ctx = context.Background()
+	}
+	ctx := x.ctx
+
+	var errs []error
+	urns := make([]string, 0, len(posts))
+	for _, p := range posts {
+		urn, err := linkdapi.ParsePostURN(p)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("audience: %w", err))
+			continue
+		}
+		urns = append(urns, urn.String())
+	}
+
+	results, _ := linkdapi.Batch(ctx, urns, x.post, &linkdapi.BatchOptions{Workers: x.opts.Workers})
+
+	// Merge in post order, so the result does not depend on timing
+	m := newMerger(urns)
+	for _, r := range results {
+		if r.Err != nil && !errors.Is(r.Err, ctx.Err()) {
+			errs = append(errs, fmt.Errorf("audience: post %s: %w", r.Item, r.Err))
+		}
+		for _, eng := range r.Value {
+			m.add(r.Item, eng)
+		}
+	}
+	engagers := make([]Engager, 0, len(m.engagers))
+	for _, e := range m.engagers {
+		if e.Total() >= x.opts.MinEngagements {
+			engagers = append(engagers, *e)
+		}
+	}
+
+	sort.SliceStable(engagers, func(i, j int) bool { return engagers[i].Total() > engagers[j].Total() })
+
+	if x.opts.Enrich && ctx.Err() == nil {
+		enriched, _ := linkdapi.Batch(ctx, engagers, x.enrich, &linkdapi.BatchOptions{Workers: x.opts.Workers})
+		for i, r := range enriched {
+			if r.Err != nil {
+				if !errors.Is(r.Err, ctx.Err()) {
+					errs = append(errs, fmt.Errorf("audience: enriching %s: %w", r.Item.Key(), r.Err))
+				}
+				continue
+			}
+			engagers[i].Profile = r.Value
+			fillMissing(&engagers[i], person(r.Value))
+		}
+	}
+	return engagers, errors.Join(errs...)
+}
+
+// engagement is one engagement of a person with a post.
+type engagement struct {
+	kind     Engagement
+	person   Engager
+	reaction string
+}
+
+// merger combines engagements into one Engager per person. A person may be
+// listed with only a URN in one place and only a username in another; their
+// records are merged as soon as an item lists both.
+type merger struct {
+	engagers []*Engager
+	index    map[string]*Engager // Identity -> engager
+	order    map[string]int      // Post URN -> input position
+}
+
+func newMerger(posts []string) *merger {
+	m := &merger{index: make(map[string]*Engager), order: make(map[string]int, len(posts))}
+	for i, p := range posts {
+		m.order[p] = i
+	}
+	return m
+}
+
+// add counts an engagement with post.
+func (m *merger) add(post string, eng engagement) {
+	var e *Engager
+	for _, key := range identities(eng.person) {
+		switch other, ok := m.index[key]; {
+		case !ok:
+		case e == nil:
+			e = other
+		case other != e:
+			m.merge(e, other)
+		}
+	}
+	if e == nil {
+		p := eng.person
+		p.Counts = make(map[Engagement]int)
+		e = &p
+		m.engagers = append(m.engagers, e)
+	}
+	fillMissing(e, eng.person)
+	for _, key := range append(identities(*e), identities(eng.person)...) {
+		m.index[key] = e
+	}
+
+	e.Counts[eng.kind]++
+	if eng.reaction != "" {
+		if e.Reactions == nil {
+			e.Reactions = make(map[string]int)
+		}
+		e.Reactions[eng.reaction]++
+	}
+	m.addPost(e, post)
+}
+
+// merge folds other into e, which takes its place.
+func (m *merger) merge(e, other *Engager) {
+	fillMissing(e, *other)
+	for kind, n := range other.Counts {
+		e.Counts[kind] += n
+	}
+	for reaction, n := range other.Reactions {
+		if e.Reactions == nil {
+			e.Reactions = make(map[string]int)
+		}
+		e.Reactions[reaction] += n
+	}
+	for _, post := range other.Posts {
+		m.addPost(e, post)
+	}
+	for key, v := range m.index {
+		if v == other {
+			m.index[key] = e
+		}
+	}
+	m.engagers = slices.DeleteFunc(m.engagers, func(v *Engager) bool { return v == other })
+}
+
+// addPost adds post to e's posts, once and in input order.
+func (m *merger) addPost(e *Engager, post string) {
+	i, found := slices.BinarySearchFunc(e.Posts, post, func(a, b string) int { return m.order[a] - m.order[b] })
+	if !found {
+		e.Posts = slices.Insert(e.Posts, i, post)
+	}
+}
+
+type extractor struct {
+	api  API
+	opts Options
+	ctx  context.Context
+}
+
+// pager tells when to stop paging through a list. Its page size is given,
+// or taken from the first page: a shorter page is the last. A page starting
+// with the same item as the previous one is a repeat, as APIs may return
+// past the end, and is dropped.
+type pager struct {
+	size  int
+	first string
+}
+
+// fresh records a fetched page and reports whether it is not a repeat.
+func (p *pager) fresh(items []map[string]any) bool {
+	if len(items) == 0 {
+		return true
+	}
+	first := fmt.Sprint(items[0])
+	if first == p.first {
+		return false
+	}
+	p.first = first
+	return true
+}
+
+// more reports whether a page may follow one of n items.
+func (p *pager) more(n int) bool {
+	if p.size == 0 {
+		p.size = n
+	}
+	return n > 0 && n >= p.size
+}
+
+// post collects the engagements with one post. Engagements found before a
+// failure are still returned.
+func (x extractor) post(urn string) ([]engagement, error) {
+	var engagements []engagement
+	ctx := linkdapi.WithContext(x.ctx)
+
+	var likes pager
+	for page, start := 0, 0; page < x.opts.MaxPages; page++ {
+		resp, err := x.api.GetPostLikes(urn, start, ctx)
+		if err != nil {
+			return engagements, fmt.Errorf("failed to get likes: %w", err)
+		}
+		items, _, err := listItems(resp, "likes", "reactions", "reactors")
+		if err != nil {
+			return engagements, fmt.Errorf("failed to get likes: %w", err)
+		}
+		if !likes.fresh(items) {
+			break
+		}
+		for _, item := range items {
+			if p, ok := parsePerson(item); ok {
+				engagements = append(engagements, engagement{kind: Like, person: p, reaction: reactionType(item)})
+			}
+		}
+		if !likes.more(len(items)) {
+			break
+		}
+		start += len(items)
+	}
+
+	var comments []string
+	cursor := ""
+	commentPages := pager{size: commentsPageSize}
+	for page, start := 0, 0; page < x.opts.MaxPages; page++ {
+		var resp map[string]any
+		var err error
+		if cursor != "" {
+			resp, err = x.api.GetPostComments(urn, 0, commentsPageSize, cursor, ctx)
+		} else {
+			resp, err = x.api.GetPostComments(urn, start, commentsPageSize, "", ctx)
+		}
+		if err != nil {
+			return engagements, fmt.Errorf("failed to get comments: %w", err)
+		}
+		items, next, err := listItems(resp, "comments")
+		if err != nil {
+			return engagements, fmt.Errorf("failed to get comments: %w", err)
+		}
+		if !commentPages.fresh(items) {
+			break
+		}
+		for _, item := range items {
+			if p, ok := parsePerson(item); ok {
+				engagements = append(engagements, engagement{kind: Comment, person: p})
+			}
+			if c := commentURN(item); c != "" {
+				comments = append(comments, c)
+			}
+		}
+		// A cursor, when the API returns one, says more follow
+		if len(items) == 0 || next == "" && !commentPages.more(len(items)) {
+			break
+		}
+		cursor = next
+		start += len(items)
+	}
+
+	if !x.opts.CommentLikes {
+		return engagements, nil
+	}
+	for len(comments) > 0 {
+		n := min(commentLikesBatch, len(comments))
+		batch := strings.Join(comments[:n], ",")
+		comments = comments[n:]
+
+		var likes pager
+		for page, start := 0, 0; page < x.opts.MaxPages; page++ {
+			resp, err := x.api.GetCommentLikes(batch, start, ctx)
+			if err != nil {
+				return engagements, fmt.Errorf("failed to get comment likes: %w", err)
+			}
+			items, _, err := listItems(resp, "likes", "reactions", "reactors")
+			if err != nil {
+				return engagements, fmt.Errorf("failed to get comment likes: %w", err)
+			}
+			if !likes.fresh(items) {
+				break
+			}
+			for _, item := range items {
+				if p, ok := parsePerson(item); ok {
+					engagements = append(engagements, engagement{kind: CommentLike, person: p, reaction: reactionType(item)})
+				}
+			}
+			if !likes.more(len(items)) {
+				break
+			}
+			start += len(items)
+		}
+	}
+	return engagements, nil
+}
+
+// enrich fetches an engager's profile data.
+func (x extractor) enrich(e Engager) (map[string]any, error) {
+	var resp map[string]any
+	var err error
+	if e.Username != "" {
+		resp, err = x.api.GetProfileOverview(e.Username, linkdapi.WithContext(x.ctx))
+	} else {
+		resp, err = x.api.GetProfileDetails(e.URN, linkdapi.WithContext(x.ctx))
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := apiutil.Data(resp)
+	if err != nil {
+		return nil, err
+	}
+	m, _ := data.(map[string]any)
+	return m, nil
+}
+
+// parsePerson returns the person described by a like or comment, which
+// either nests them under a key such as "actor" or is flat. Items without a
+// profile URN or username, such as company pages, are skipped.
+func parsePerson(item map[string]any) (Engager, bool) {
+	for _, key := range []string{"actor", "author", "commenter", "reactor", "profile", "user"} {
+		if m, ok := item[key].(map[string]any); ok {
+			item = m
+			break
+		}
+	}
+	p := person(item)
+	return p, p.URN != "" || p.Username != ""
+}
+
+// person extracts identity fields from a profile-like object.
+func person(m map[string]any) Engager {
+	var p Engager
+	for _, key := range []string{"urn", "profileUrn", "profileURN", "entityUrn", "id"} {
+		if urn, err := linkdapi.ParseProfileURN(apiutil.String(m[key])); err == nil {
+			p.URN = urn.String()
+			break
+		}
+	}
+	p.Username = apiutil.First(m, "publicIdentifier", "username", "publicId")
+	p.Name = apiutil.First(m, "fullName", "name")
+	if p.Name == "" {
+		p.Name = strings.TrimSpace(apiutil.First(m, "firstName") + " " + apiutil.First(m, "lastName"))
+	}
+	p.Headline = apiutil.First(m, "headline", "occupation", "title")
+	return p
+}
+
+// identities returns the de-duplication keys of a person.
+func identities(p Engager) []string {
+	var keys []string
+	if p.URN != "" {
+		keys = append(keys, p.URN)
+	}
+	if p.Username != "" {
+		keys = append(keys, "username:"+p.Username)
+	}
+	return keys
+}
+
+// fillMissing copies the identity fields of from that e lacks.
+func fillMissing(e *Engager, from Engager) {
+	if e.URN == "" {
+		e.URN = from.URN
+	}
+	if e.Username == "" {
+		e.Username = from.Username
+	}
+	if e.Name == "" {
+		e.Name = from.Name
+	}
+	if e.Headline == "" {
+		e.Headline = from.Headline
+	}
+}
+
+func reactionType(item map[string]any) string {
+	return strings.ToUpper(apiutil.First(item, "reactionType", "reaction", "type"))
+}
+
+func commentURN(item map[string]any) string {
+	for _, key := range []string{"urn", "commentUrn", "commentURN", "id"} {
+		if urn, err := linkdapi.ParseCommentURN(apiutil.String(item[key])); err == nil {
+			return urn.String()
+		}
+	}
+	return ""
+}
+
+// listItems returns the objects listed in a response, either as its data or
+// under one of keys in it, and the cursor of the next page, if any.
+func listItems(resp map[string]any, keys ...string) ([]map[string]any, string, error) {
+	data, err := apiutil.Data(resp)
+	if err != nil {
+		return nil, "", err
+	}
+	m, _ := data.(map[string]any)
+	cursor := apiutil.First(m, "cursor", "nextCursor", "paginationToken")
+	return apiutil.Objects(apiutil.List(data, slices.Concat(keys, apiutil.ListKeys)...)), cursor, nil
+}
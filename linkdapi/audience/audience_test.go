@@ -0,0 +1,147 @@
+package audience
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapimock"
+)
+
+const (
+	post1   = "7211111111111111111"
+	post2   = "7222222222222222222"
+	aliceID = "ACoAAAEkwwAB9KEc2TrQgOLEQ-vzRyZeCDyc6DQ"
+	bobID   = "ACoAAB0bBobBobBobBobBobBobBobBobBobBob"
+)
+
+func ok(data map[string]any) (map[string]any, error) {
+	return map[string]any{"success": true, "data": data}, nil
+}
+
+// reactors returns a likes page listing people, given as actor objects.
+func reactors(people ...map[string]any) map[string]any {
+	items := make([]any, len(people))
+	for i, p := range people {
+		items[i] = map[string]any{"actor": p, "reactionType": "LIKE"}
+	}
+	return map[string]any{"likes": items}
+}
+
+// noComments answers GetPostComments with no comments.
+func noComments(mock *linkdapimock.Client) {
+	mock.GetPostCommentsFunc = func(string, int, int, string, ...linkdapi.RequestOption) (map[string]any, error) {
+		return ok(map[string]any{"comments": []any{}})
+	}
+}
+
+func TestExtractMerges(t *testing.T) {
+	mock := &linkdapimock.Client{}
+	mock.GetPostLikesFunc = func(urn string, start int, _ ...linkdapi.RequestOption) (map[string]any, error) {
+		if start > 0 {
+			return ok(reactors())
+		}
+		if urn == post1 {
+			// Alice is listed by URN only
+			return ok(reactors(map[string]any{"urn": aliceID}))
+		}
+		return ok(reactors(map[string]any{"urn": bobID, "username": "bob"}))
+	}
+	mock.GetPostCommentsFunc = func(urn string, _, _ int, _ string, _ ...linkdapi.RequestOption) (map[string]any, error) {
+		if urn == post1 {
+			// Then by username only, and by both on a comment of the second post
+			return ok(map[string]any{"comments": []any{
+				map[string]any{"author": map[string]any{"username": "alice", "fullName": "Alice"}},
+			}})
+		}
+		return ok(map[string]any{"comments": []any{
+			map[string]any{"author": map[string]any{"urn": aliceID, "username": "alice"}},
+			map[string]any{"author": map[string]any{"username": "bob"}},
+		}})
+	}
+
+	got, err := Extract(mock, []string{post1, post2}, &Options{Workers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Engager{
+		{URN: aliceID, Username: "alice", Name: "Alice", Counts: map[Engagement]int{Like: 1, Comment: 2},
+			Reactions: map[string]int{"LIKE": 1}, Posts: []string{post1, post2}},
+		{URN: bobID, Username: "bob", Counts: map[Engagement]int{Like: 1, Comment: 1},
+			Reactions: map[string]int{"LIKE": 1}, Posts: []string{post2}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Extract =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestExtractPaging(t *testing.T) {
+	alice := map[string]any{"urn": aliceID}
+	bob := map[string]any{"username": "bob"}
+	carol := map[string]any{"username": "carol"}
+	tests := []struct {
+		name  string
+		pages map[int]map[string]any // Likes by start offset; missing offsets repeat page 0
+		calls int
+		likes int
+	}{
+		{"until short page", map[int]map[string]any{0: reactors(alice, bob), 2: reactors(carol)}, 2, 3},
+		{"until empty page", map[int]map[string]any{0: reactors(alice, bob), 2: reactors(carol, alice), 4: reactors()}, 3, 4},
+		{"until repeated page", map[int]map[string]any{0: reactors(alice, bob)}, 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &linkdapimock.Client{}
+			noComments(mock)
+			mock.GetPostLikesFunc = func(_ string, start int, _ ...linkdapi.RequestOption) (map[string]any, error) {
+				if page, ok := tt.pages[start]; ok {
+					return map[string]any{"success": true, "data": page}, nil
+				}
+				return map[string]any{"success": true, "data": tt.pages[0]}, nil
+			}
+
+			engagers, err := Extract(mock, []string{post1}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := len(mock.GetPostLikesCalls()); n != tt.calls {
+				t.Errorf("GetPostLikes calls = %d, want %d", n, tt.calls)
+			}
+			likes := 0
+			for _, e := range engagers {
+				likes += e.Counts[Like]
+			}
+			if likes != tt.likes {
+				t.Errorf("likes = %d, want %d", likes, tt.likes)
+			}
+		})
+	}
+}
+
+func TestExtractMinEngagements(t *testing.T) {
+	mock := &linkdapimock.Client{}
+	noComments(mock)
+	mock.GetPostLikesFunc = func(urn string, start int, _ ...linkdapi.RequestOption) (map[string]any, error) {
+		if start > 0 {
+			return ok(reactors())
+		}
+		if urn == post1 {
+			return ok(reactors(map[string]any{"username": "alice"}, map[string]any{"username": "bob"}))
+		}
+		return ok(reactors(map[string]any{"username": "alice"}))
+	}
+	mock.GetProfileOverviewFunc = func(username string, _ ...linkdapi.RequestOption) (map[string]any, error) {
+		return ok(map[string]any{"fullName": "Alice"})
+	}
+
+	got, err := Extract(mock, []string{post1, post2}, &Options{MinEngagements: 2, Enrich: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Username != "alice" || got[0].Name != "Alice" {
+		t.Errorf("Extract = %+v, want only alice, enriched", got)
+	}
+	if n := len(mock.GetProfileOverviewCalls()); n != 1 {
+		t.Errorf("GetProfileOverview calls = %d, want only the engager kept", n)
+	}
+}
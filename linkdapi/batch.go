@@ -0,0 +1,150 @@
+package linkdapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrBatchSkipped is the error of batch items that were not run because an
+// earlier item failed in fail-fast mode.
+var ErrBatchSkipped = errors.New("skipped after an earlier failure")
+
+// BatchOptions configures Batch.
+type BatchOptions struct {
+	// Workers is the number of items processed in parallel (default: 4)
+	Workers int
+
+	// FailFast stops starting new items after the first failure (default: false, run every item)
+	FailFast bool
+
+	// Progress, if set, is called after each item finishes with the number of
+	// finished items and the total. Calls are serialized.
+	Progress func(done, total int)
+}
+
+// BatchResult is the outcome of one Batch item.
+type BatchResult[T, R any] struct {
+	Item  T     // The input item
+	Value R     // What fn returned for Item, if Err is nil
+	Err   error // fn's error, ErrBatchSkipped, or the context's error if not run
+}
+
+// Batch runs fn over items using a bounded number of workers. Results are
+// returned in the order of items, each with its own error. The returned error
+// joins the errors of the items that failed, or is the context's error if ctx
+// was done before every item started; it is nil if every item succeeded.
+//
+// Items not yet started when ctx is done, or after a failure in fail-fast
+// mode, are not run. Items already running are not interrupted.
+//
+// Example:
+//
+//	results, err := linkdapi.Batch(ctx, usernames, func(u string) (map[string]any, error) {
+//	    return client.GetProfileOverview(u)
+//	}, &linkdapi.BatchOptions{Workers: 8})
+//	for _, r := range results {
+//	    if r.Err != nil {
+//	        log.Printf("%s: %v", r.Item, r.Err)
+//	    }
+//	}
+func Batch[T, R any](ctx context.Context, items []T, fn func(T) (R, error), options *BatchOptions) ([]BatchResult[T, R], error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if options == nil {
+		options = &BatchOptions{}
+	}
+	workers := options.Workers
+	if workers <= 0 {
+		workers = 4
+	}
+	workers = min(workers, len(items))
+
+	results := make([]BatchResult[T, R], len(items))
+	for i, item := range items {
+		results[i].Item = item
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		done    int
+		failed  bool
+		stopped error // Why feeding stopped early, if it did
+		started = make([]bool, len(items))
+		next    = make(chan int)
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				// The feeder may have handed over i before a failure in
+				// flight was recorded, so check again before running it
+				mu.Lock()
+				skip := failed && options.FailFast
+				if skip {
+					results[i].Err = ErrBatchSkipped
+				}
+				mu.Unlock()
+				if skip {
+					continue
+				}
+
+				v, err := fn(items[i])
+
+				mu.Lock()
+				results[i].Value, results[i].Err = v, err
+				if err != nil {
+					failed = true
+				}
+				done++
+				if options.Progress != nil {
+					options.Progress(done, len(items))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for i := range items {
+		mu.Lock()
+		stop := failed && options.FailFast
+		mu.Unlock()
+		if stop {
+			stopped = ErrBatchSkipped
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			stopped = err
+			break
+		}
+		select {
+		case <-ctx.Done():
+			stopped = ctx.Err()
+			break feed
+		case next <- i:
+			started[i] = true
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	var errs []error
+	for i := range results {
+		switch {
+		case !started[i]:
+			results[i].Err = stopped
+		case results[i].Err == ErrBatchSkipped:
+			// Handed to a worker after the failure, but not run
+		case results[i].Err != nil:
+			errs = append(errs, results[i].Err)
+		}
+	}
+	if stopped != nil && stopped != ErrBatchSkipped {
+		return results, stopped
+	}
+	return results, errors.Join(errs...)
+}
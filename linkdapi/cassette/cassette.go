@@ -0,0 +1,361 @@
+// Package cassette provides a record/replay http.RoundTripper for
+// deterministic tests against LinkdAPI.
+//
+// Record real interactions once:
+//
+//	rec, err := cassette.New("testdata/profile.json", cassette.ModeRecord, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	client := linkdapi.NewClientWithConfig(apiKey, &linkdapi.Config{
+//	    BaseURL:   "https://linkdapi.com",
+//	    Transport: rec,
+//	})
+//	// ... make calls ...
+//	if err := rec.Save(); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Then replay them without network access or an API key by opening the same
+// cassette with ModeReplay.
+//
+// Only the method, path and sorted query of each request are recorded; request
+// headers, including X-linkdapi-apikey, are never written to the cassette.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Mode selects whether the Recorder talks to the network.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and never touches the network.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends every request to the real transport and records the
+	// interaction. Existing interactions in the cassette are discarded.
+	ModeRecord
+
+	// ModeAuto replays if the cassette file exists and records otherwise.
+	ModeAuto
+)
+
+// Matching selects how replayed requests are matched against recorded ones.
+type Matching int
+
+const (
+	// MatchStrict requires the method, path and full query to be equal.
+	// Each recorded interaction is replayed at most once, in recorded order.
+	MatchStrict Matching = iota
+
+	// MatchLenient requires only the method and path to be equal. The
+	// interaction sharing the most query parameters wins and interactions
+	// may be replayed any number of times.
+	MatchLenient
+)
+
+// Request is the recorded form of an HTTP request.
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"` // Sorted, URL-encoded query string
+}
+
+// String returns the request as "METHOD path?query".
+func (r Request) String() string {
+	if r.Query == "" {
+		return r.Method + " " + r.Path
+	}
+	return r.Method + " " + r.Path + "?" + r.Query
+}
+
+// Response is the recorded form of an HTTP response.
+type Response struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body"`
+}
+
+// Interaction is a recorded request/response pair.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the on-disk format of a recording.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Options configures a Recorder.
+type Options struct {
+	// Matching selects the replay matching mode (default: MatchStrict)
+	Matching Matching
+
+	// Transport is the real transport used when recording (default: http.DefaultTransport)
+	Transport http.RoundTripper
+}
+
+// UnmatchedError is returned by RoundTrip when a request has no recorded interaction.
+type UnmatchedError struct {
+	Request Request
+	Path    string   // Cassette file
+	Nearest []string // Recorded requests to the same method and path
+}
+
+func (e *UnmatchedError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "cassette %s: no recorded interaction for %s", e.Path, e.Request)
+	if len(e.Nearest) == 0 {
+		b.WriteString(" (no interactions recorded for this path)")
+		return b.String()
+	}
+	b.WriteString("; recorded for this path:")
+	for _, n := range e.Nearest {
+		b.WriteString("\n\t")
+		b.WriteString(n)
+	}
+	return b.String()
+}
+
+// Recorder is an http.RoundTripper that records or replays interactions.
+//
+// The Recorder is safe for concurrent use by multiple goroutines.
+type Recorder struct {
+	path      string
+	recording bool
+	matching  Matching
+	transport http.RoundTripper
+
+	mu        sync.Mutex
+	cassette  Cassette
+	used      []bool
+	unmatched []Request
+}
+
+// New creates a Recorder backed by the cassette file at path.
+// A nil opts uses strict matching and http.DefaultTransport.
+func New(path string, mode Mode, opts *Options) (*Recorder, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	r := &Recorder{
+		path:      path,
+		matching:  opts.Matching,
+		transport: opts.Transport,
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+
+	switch mode {
+	case ModeRecord:
+		r.recording = true
+	case ModeAuto:
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			r.recording = true
+		}
+	case ModeReplay:
+	default:
+		return nil, fmt.Errorf("cassette: unknown mode %d", mode)
+	}
+
+	if !r.recording {
+		if err := r.load(); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Recording reports whether the Recorder is recording rather than replaying.
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded := newRequest(req)
+	if r.recording {
+		return r.record(req, recorded)
+	}
+	return r.replay(req, recorded)
+}
+
+// Save writes the recorded interactions to the cassette file.
+// It is a no-op when replaying.
+func (r *Recorder) Save() error {
+	if !r.recording {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("cassette: failed to encode %s: %w", r.path, err)
+	}
+
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("cassette: failed to write %s: %w", r.path, err)
+	}
+	return nil
+}
+
+// Unmatched returns the requests that could not be replayed.
+func (r *Recorder) Unmatched() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Request(nil), r.unmatched...)
+}
+
+// Unused returns the recorded interactions that have not been replayed.
+// With MatchStrict, a non-empty result usually means the code under test
+// made fewer calls than when the cassette was recorded.
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []Interaction
+	for i, in := range r.cassette.Interactions {
+		if !r.used[i] {
+			out = append(out, in)
+		}
+	}
+	return out
+}
+
+func (r *Recorder) load() error {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return fmt.Errorf("cassette: failed to read %s: %w", r.path, err)
+	}
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return fmt.Errorf("cassette: failed to parse %s: %w", r.path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return nil
+}
+
+func (r *Recorder) record(req *http.Request, recorded Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			Status: resp.StatusCode,
+			Header: resp.Header.Clone(),
+			Body:   string(body),
+		},
+	})
+	r.used = append(r.used, true)
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := r.match(recorded)
+	if i < 0 {
+		r.unmatched = append(r.unmatched, recorded)
+		return nil, &UnmatchedError{Request: recorded, Path: r.path, Nearest: r.nearest(recorded)}
+	}
+	r.used[i] = true
+
+	in := r.cassette.Interactions[i].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.Status, http.StatusText(in.Status)),
+		StatusCode:    in.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        in.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(in.Body)),
+		ContentLength: int64(len(in.Body)),
+		Request:       req,
+	}, nil
+}
+
+// match returns the index of the interaction to replay, or -1.
+// It must be called with r.mu held.
+func (r *Recorder) match(req Request) int {
+	if r.matching == MatchStrict {
+		for i, in := range r.cassette.Interactions {
+			if !r.used[i] && in.Request == req {
+				return i
+			}
+		}
+		return -1
+	}
+
+	want, _ := url.ParseQuery(req.Query)
+	best, bestScore := -1, -1
+	for i, in := range r.cassette.Interactions {
+		if in.Request.Method != req.Method || in.Request.Path != req.Path {
+			continue
+		}
+		got, _ := url.ParseQuery(in.Request.Query)
+		score := 0
+		for key := range want {
+			if got.Get(key) == want.Get(key) {
+				score++
+			}
+		}
+		if score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+// nearest lists recorded requests to the same method and path.
+// It must be called with r.mu held.
+func (r *Recorder) nearest(req Request) []string {
+	var out []string
+	for i, in := range r.cassette.Interactions {
+		if in.Request.Method != req.Method || in.Request.Path != req.Path {
+			continue
+		}
+		s := in.Request.String()
+		if r.used[i] {
+			s += " (already replayed)"
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func newRequest(req *http.Request) Request {
+	return Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(), // Encode sorts by key
+	}
+}
@@ -0,0 +1,161 @@
+package cassette
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapitest"
+)
+
+const overview = "api/v1/profile/overview"
+
+func TestRecordReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profile.json")
+
+	s := linkdapitest.NewServer("test_key")
+	s.Fixture(overview, map[string]string{"username": "ryanroslansky"}, map[string]any{"fullName": "Ryan Roslansky"})
+
+	rec, err := New(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rec.Recording() {
+		t.Fatal("Recording() = false in ModeRecord")
+	}
+	client := linkdapi.NewClientWithConfig("test_key", &linkdapi.Config{BaseURL: s.URL, Transport: rec})
+	if _, err := client.GetProfileOverview("ryanroslansky"); err != nil {
+		t.Fatalf("recording: %v", err)
+	}
+	client.Close()
+	s.Close()
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if saved, err := os.ReadFile(path); err != nil {
+		t.Fatal(err)
+	} else if strings.Contains(string(saved), "test_key") {
+		t.Error("cassette contains the API key")
+	}
+
+	// The server is gone, so the response can only come from the cassette
+	rep, err := New(path, ModeAuto, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Recording() {
+		t.Fatal("Recording() = true in ModeAuto with an existing cassette")
+	}
+	client = linkdapi.NewClientWithConfig("other_key", &linkdapi.Config{BaseURL: s.URL, Transport: rep, MaxRetries: 1})
+	defer client.Close()
+
+	resp, err := client.GetProfileOverview("ryanroslansky")
+	if err != nil {
+		t.Fatalf("replaying: %v", err)
+	}
+	data, _ := resp["data"].(map[string]any)
+	if data["fullName"] != "Ryan Roslansky" {
+		t.Errorf("replayed data = %v, want the recorded fixture", resp["data"])
+	}
+	if unused := rep.Unused(); len(unused) != 0 {
+		t.Errorf("Unused() = %v, want none", unused)
+	}
+}
+
+func TestReplayUnmatched(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profile.json")
+	writeCassette(t, path, Interaction{
+		Request:  Request{Method: http.MethodGet, Path: "/" + overview, Query: "username=ryanroslansky"},
+		Response: Response{Status: http.StatusOK, Body: `{"success":true,"data":{}}`},
+	})
+
+	rec, err := New(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://linkdapi.com/"+overview+"?username=someone", nil)
+	_, err = rec.RoundTrip(req)
+
+	var unmatched *UnmatchedError
+	if !errors.As(err, &unmatched) {
+		t.Fatalf("RoundTrip err = %v, want an *UnmatchedError", err)
+	}
+	if unmatched.Request.Query != "username=someone" {
+		t.Errorf("Request = %v, want the unmatched request", unmatched.Request)
+	}
+	if len(unmatched.Nearest) != 1 || !strings.Contains(unmatched.Nearest[0], "username=ryanroslansky") {
+		t.Errorf("Nearest = %v, want the recorded request", unmatched.Nearest)
+	}
+	if got := rec.Unmatched(); len(got) != 1 {
+		t.Errorf("Unmatched() = %v, want one request", got)
+	}
+
+	// Strict matching replays each interaction once
+	req, _ = http.NewRequest(http.MethodGet, "https://linkdapi.com/"+overview+"?username=ryanroslansky", nil)
+	for i, wantErr := range []bool{false, true} {
+		resp, err := rec.RoundTrip(req)
+		if (err != nil) != wantErr {
+			t.Errorf("replay %d: err = %v, want error %v", i, err, wantErr)
+		}
+		if err == nil {
+			resp.Body.Close()
+		}
+	}
+}
+
+func TestReplayLenient(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profile.json")
+	writeCassette(t, path,
+		Interaction{
+			Request:  Request{Method: http.MethodGet, Path: "/" + overview, Query: "username=a"},
+			Response: Response{Status: http.StatusOK, Body: "a"},
+		},
+		Interaction{
+			Request:  Request{Method: http.MethodGet, Path: "/" + overview, Query: "username=b"},
+			Response: Response{Status: http.StatusOK, Body: "b"},
+		},
+	)
+
+	rec, err := New(path, ModeReplay, &Options{Matching: MatchLenient})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Lenient matching replays repeatedly and falls back to the same path
+	for _, tt := range []struct{ username, want string }{{"b", "b"}, {"b", "b"}, {"c", "a"}} {
+		req, _ := http.NewRequest(http.MethodGet, "https://linkdapi.com/"+overview+"?username="+tt.username, nil)
+		resp, err := rec.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("username %s: %v", tt.username, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != tt.want {
+			t.Errorf("username %s: body = %q, want %q", tt.username, body, tt.want)
+		}
+	}
+}
+
+func TestNewMissingCassette(t *testing.T) {
+	if _, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil); err == nil {
+		t.Error("New(ModeReplay) with a missing cassette succeeded")
+	}
+}
+
+// writeCassette saves interactions to path through a recording Recorder.
+func writeCassette(t *testing.T, path string, interactions ...Interaction) {
+	t.Helper()
+
+	rec, err := New(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.cassette.Interactions = interactions
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+}
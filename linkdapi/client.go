@@ -3,10 +3,12 @@ package linkdapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -21,13 +23,16 @@ import (
 //
 // The Client is safe for concurrent use by multiple goroutines.
 type Client struct {
-	apiKey      string
-	baseURL     string
-	httpClient  *http.Client
-	maxRetries  int
-	retryDelay  time.Duration
-	timeout     time.Duration
-	ctx         context.Context // Context for all requests
+	keys       KeyProvider
+	baseURL    string
+	httpClient *http.Client
+	maxRetries int
+	retryDelay time.Duration
+	timeout    time.Duration
+	ctx        context.Context // Context for all requests
+	resolver   *Resolver       // Memoized username and company name lookups
+	limiter    *rateLimiter    // Spaces requests per Config.RateLimit, nil if unlimited
+	usage      *usageTracker   // Billable calls and credits spent
 }
 
 // NewClient creates a new LinkdAPI client with default configuration.
@@ -61,22 +66,39 @@ func NewClientWithConfig(apiKey string, config *Config) *Client {
 		ctx = context.Background()
 	}
 
-	return &Client{
-		apiKey:     apiKey,
+	transport := config.Transport
+	if transport == nil {
+		transport = &http.Transport{
+			MaxIdleConns:        100,
+			MaxIdleConnsPerHost: 10,
+			IdleConnTimeout:     90 * time.Second,
+		}
+	}
+
+	var keys KeyProvider = staticKey(apiKey)
+	switch {
+	case config.Keys != nil:
+		keys = config.Keys
+	case config.Credentials != nil:
+		keys = credentialsKeys{config.Credentials}
+	}
+
+	client := &Client{
+		keys:       keys,
 		baseURL:    strings.TrimRight(config.BaseURL, "/"),
 		maxRetries: config.MaxRetries,
 		retryDelay: config.RetryDelay,
 		timeout:    config.Timeout,
 		ctx:        ctx,
+		limiter:    newRateLimiter(config.RateLimit),
+		usage:      newUsageTracker(config.Budget),
 		httpClient: &http.Client{
-			Timeout: config.Timeout,
-			Transport: &http.Transport{
-				MaxIdleConns:        100,
-				MaxIdleConnsPerHost: 10,
-				IdleConnTimeout:     90 * time.Second,
-			},
+			Timeout:   config.Timeout,
+			Transport: transport,
 		},
 	}
+	client.resolver = newResolver(client, config.ResolverCacheFile)
+	return client
 }
 
 // Close closes the HTTP client and releases resources.
@@ -86,31 +108,58 @@ func (c *Client) Close() {
 	}
 }
 
-// getHeaders returns the default headers for API requests.
-func (c *Client) getHeaders() map[string]string {
+// getHeaders returns the default headers for API requests made with apiKey.
+func (c *Client) getHeaders(apiKey string) map[string]string {
 	return map[string]string{
-		"X-linkdapi-apikey": c.apiKey,
+		"X-linkdapi-apikey": apiKey,
 		"Accept":            "application/json",
 		"Content-Type":      "application/json",
 		"User-Agent":        "LinkdAPI-Go-Client/1.0",
 	}
 }
 
-// sendRequest sends an HTTP request with retry logic using the client's context.
-func (c *Client) sendRequest(method, endpoint string, params map[string]string) (map[string]any, error) {
-	ctx := c.ctx
-	requestURL := fmt.Sprintf("%s/%s", c.baseURL, strings.TrimLeft(endpoint, "/"))
+// callContext returns the context for a call made with options o, which
+// may be nil: o's context if set, else the client's, canceled when either
+// is done and bounded by o's deadline.
+func (c *Client) callContext(o *requestOptions) (context.Context, context.CancelFunc) {
+	if o == nil || o.ctx == nil && o.deadline.IsZero() {
+		return context.WithCancel(c.ctx)
+	}
+
+	parent := c.ctx
+	if o.ctx != nil {
+		parent = o.ctx
+	}
+	ctx, cancel := context.WithCancel(parent)
+	if parent != c.ctx {
+		stop := context.AfterFunc(c.ctx, cancel)
+		cancelCtx := cancel
+		cancel = func() { stop(); cancelCtx() }
+	}
+	if !o.deadline.IsZero() {
+		var cancelDeadline context.CancelFunc
+		ctx, cancelDeadline = context.WithDeadline(ctx, o.deadline)
+		cancelCtx := cancel
+		cancel = func() { cancelDeadline(); cancelCtx() }
+	}
+	return ctx, cancel
+}
+
+// sendRequest sends an HTTP request with retry logic using the client's context
+// and the per-call options o, which may be nil.
+func (c *Client) sendRequest(method, endpoint string, params map[string]string, o *requestOptions) (map[string]any, error) {
+	ctx, cancel := c.callContext(o)
+	defer cancel()
+	endpoint = strings.TrimLeft(endpoint, "/")
+	requestURL := fmt.Sprintf("%s/%s", c.baseURL, endpoint)
 
 	// Add query parameters
 	if len(params) > 0 {
-		urlParams := url.Values{}
-		for key, value := range params {
-			urlParams.Add(key, value)
-		}
-		requestURL = fmt.Sprintf("%s?%s", requestURL, urlParams.Encode())
+		requestURL = fmt.Sprintf("%s?%s", requestURL, encodeQuery(params))
 	}
 
 	var lastErr error
+	rotations := 0
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
 		// Check if context is already cancelled
 		select {
@@ -119,23 +168,49 @@ func (c *Client) sendRequest(method, endpoint string, params map[string]string)
 		default:
 		}
 
+		// Wait for the rate limit
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
 		// Create request
 		req, err := http.NewRequestWithContext(ctx, method, requestURL, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
 
+		// Pick the API key for this attempt
+		apiKey, err := c.apiKey(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get API key: %w", err)
+		}
+
 		// Add headers
-		for key, value := range c.getHeaders() {
+		for key, value := range c.getHeaders(apiKey) {
 			req.Header.Set(key, value)
 		}
+		if o != nil {
+			if o.noCache {
+				req.Header.Set("Cache-Control", "no-cache")
+			}
+			for key, values := range o.header {
+				req.Header[key] = values
+			}
+		}
 
-		// Send request
+		// Send request, holding a credit of the budget while it is in flight
+		if err := c.usage.begin(endpoint); err != nil {
+			return nil, err
+		}
 		resp, err := c.httpClient.Do(req)
+		c.usage.end(endpoint, err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300)
 		if err != nil {
+			c.keys.Report(apiKey, 0, err.Error())
 			lastErr = err
 			if attempt < c.maxRetries {
-				time.Sleep(c.retryDelay * time.Duration(attempt+1))
+				if err := c.backoff(ctx, attempt); err != nil {
+					return nil, err
+				}
 				continue
 			}
 			return nil, fmt.Errorf("request failed after %d attempts: %w", c.maxRetries+1, err)
@@ -148,46 +223,136 @@ func (c *Client) sendRequest(method, endpoint string, params map[string]string)
 		if err != nil {
 			lastErr = err
 			if attempt < c.maxRetries {
-				time.Sleep(c.retryDelay * time.Duration(attempt+1))
+				if err := c.backoff(ctx, attempt); err != nil {
+					return nil, err
+				}
 				continue
 			}
 			return nil, fmt.Errorf("failed to read response body: %w", err)
 		}
 
-		// Check status code
+		// Check status code; client errors other than 429 will not succeed on retry
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 			lastErr = fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
-			if attempt < c.maxRetries {
-				time.Sleep(c.retryDelay * time.Duration(attempt+1))
+
+			// A rejected or throttled key is retried at once with another key
+			if c.keys.Report(apiKey, resp.StatusCode, responseMessage(body)) && rotations < maxKeyRotations {
+				rotations++
+				attempt--
+				continue
+			}
+			if attempt < c.maxRetries && retryable(resp.StatusCode) {
+				if err := c.backoff(ctx, attempt); err != nil {
+					return nil, err
+				}
 				continue
 			}
 			return nil, lastErr
 		}
 
-		// Parse JSON response
-		var result map[string]any
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+		result, err := decodeResponse(body)
+		if err == nil {
+			message, _ := result["message"].(string)
+			if success, _ := result["success"].(bool); success {
+				message = ""
+			}
+			c.keys.Report(apiKey, resp.StatusCode, message)
 		}
-
-		return result, nil
+		return result, err
 	}
 
 	return nil, lastErr
 }
 
+// apiKey returns the key for the next attempt. While every key is cooling
+// down it waits for the first to recover, returning early if ctx is done.
+func (c *Client) apiKey(ctx context.Context) (string, error) {
+	for {
+		key, err := c.keys.APIKey()
+		var cooldown *CooldownError
+		if !errors.As(err, &cooldown) {
+			return key, err
+		}
+
+		timer := time.NewTimer(time.Until(cooldown.Until))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return "", ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// maxKeyRotations bounds how often one request switches keys after a key is
+// rejected or throttled, on top of the regular retries.
+const maxKeyRotations = 10
+
+// responseMessage returns the "message" of an error response body, or the
+// body itself if it is not a JSON envelope.
+func responseMessage(body []byte) string {
+	var envelope struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &envelope) == nil && envelope.Message != "" {
+		return envelope.Message
+	}
+	return string(body)
+}
+
+// encodeQuery encodes params as a query string sorted by key.
+func encodeQuery(params map[string]string) string {
+	urlParams := url.Values{}
+	for key, value := range params {
+		urlParams.Add(key, value)
+	}
+	return urlParams.Encode()
+}
+
+// decodeResponse parses a response body, which must be a JSON object.
+func decodeResponse(body []byte) (map[string]any, error) {
+	var result map[string]any
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("failed to parse JSON response: expected an object, got null")
+	}
+	return result, nil
+}
+
+// backoff waits before the next retry attempt, returning early if ctx is done.
+func (c *Client) backoff(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(c.retryDelay * time.Duration(attempt+1))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryable reports whether a request that failed with statusCode may succeed on retry.
+func retryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
 // Helper functions for building parameter maps
 
 // stringParam adds a string parameter to the params map if the value is not empty.
-func stringParam(params map[string]string, key, value string) {
+func stringParam[T ~string](params map[string]string, key string, value T) {
 	if value != "" {
-		params[key] = value
+		params[key] = string(value)
 	}
 }
 
-// intParam adds an integer parameter to the params map.
-func intParam(params map[string]string, key string, value int) {
-	params[key] = fmt.Sprintf("%d", value)
+// intParam adds an integer parameter to the params map if the pointer is not nil.
+func intParam(params map[string]string, key string, value *int) {
+	if value != nil {
+		params[key] = strconv.Itoa(*value)
+	}
 }
 
 // boolParam adds a boolean parameter to the params map if the pointer is not nil.
@@ -202,8 +367,12 @@ func boolParam(params map[string]string, key string, value *bool) {
 }
 
 // sliceParam adds a slice parameter to the params map as comma-separated values.
-func sliceParam(params map[string]string, key string, values []string) {
+func sliceParam[T ~string](params map[string]string, key string, values []T) {
 	if len(values) > 0 {
-		params[key] = strings.Join(values, ",")
+		strs := make([]string, len(values))
+		for i, v := range values {
+			strs[i] = string(v)
+		}
+		params[key] = strings.Join(strs, ",")
 	}
 }
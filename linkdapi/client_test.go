@@ -0,0 +1,773 @@
+package linkdapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient starts a server running handler and returns a client
+// pointed at it with fast retries.
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	client := NewClientWithConfig("test_key", &Config{
+		BaseURL:    srv.URL,
+		Timeout:    5 * time.Second,
+		MaxRetries: 2,
+		RetryDelay: time.Millisecond,
+	})
+	t.Cleanup(client.Close)
+	return client, srv
+}
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(`{"success":true,"data":{}}`))
+}
+
+func TestSendRequestURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoint  string
+		params    map[string]string
+		wantPath  string
+		wantQuery string
+	}{
+		{"no params", "api/v1/profile/overview", nil, "/api/v1/profile/overview", ""},
+		{"leading slash", "/api/v1/profile/overview", nil, "/api/v1/profile/overview", ""},
+		{"sorted query", "api/v1/posts/comments", map[string]string{"urn": "123", "count": "5", "cursor": "abc"},
+			"/api/v1/posts/comments", "count=5&cursor=abc&urn=123"},
+		{"escaped values", "api/v1/jobs/search", map[string]string{"location": "San Francisco, CA", "keyword": "c++ & go"},
+			"/api/v1/jobs/search", "keyword=c%2B%2B+%26+go&location=San+Francisco%2C+CA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotQuery string
+			client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				gotPath, gotQuery = r.URL.Path, r.URL.RawQuery
+				okHandler(w, r)
+			})
+
+			if _, err := client.sendRequest("GET", tt.endpoint, tt.params, nil); err != nil {
+				t.Fatalf("sendRequest: %v", err)
+			}
+			if gotPath != tt.wantPath {
+				t.Errorf("path = %q, want %q", gotPath, tt.wantPath)
+			}
+			if gotQuery != tt.wantQuery {
+				t.Errorf("query = %q, want %q", gotQuery, tt.wantQuery)
+			}
+		})
+	}
+}
+
+func TestBaseURLTrailingSlash(t *testing.T) {
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		okHandler(w, r)
+	}))
+	defer srv.Close()
+
+	client := NewClientWithConfig("test_key", &Config{BaseURL: srv.URL + "/"})
+	defer client.Close()
+
+	if _, err := client.GetProfileOverview("someone"); err != nil {
+		t.Fatalf("GetProfileOverview: %v", err)
+	}
+	if gotPath != "/api/v1/profile/overview" {
+		t.Errorf("path = %q, want %q", gotPath, "/api/v1/profile/overview")
+	}
+}
+
+func TestSendRequestHeaders(t *testing.T) {
+	var got http.Header
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		okHandler(w, r)
+	})
+
+	if _, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil); err != nil {
+		t.Fatalf("sendRequest: %v", err)
+	}
+
+	want := map[string]string{
+		"X-Linkdapi-Apikey": "test_key",
+		"Accept":            "application/json",
+		"Content-Type":      "application/json",
+		"User-Agent":        "LinkdAPI-Go-Client/1.0",
+	}
+	for key, value := range want {
+		if got.Get(key) != value {
+			t.Errorf("header %s = %q, want %q", key, got.Get(key), value)
+		}
+	}
+}
+
+func TestNewClientWithConfigNil(t *testing.T) {
+	client := NewClientWithConfig("test_key", nil)
+	defer client.Close()
+
+	def := DefaultConfig()
+	if client.baseURL != def.BaseURL || client.maxRetries != def.MaxRetries ||
+		client.retryDelay != def.RetryDelay || client.timeout != def.Timeout {
+		t.Errorf("nil config did not apply defaults: %+v", client)
+	}
+	if client.ctx == nil {
+		t.Error("ctx is nil, want context.Background()")
+	}
+}
+
+func TestSendRequestRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int // Status per attempt; the last one repeats
+		wantErr      bool
+		wantAttempts int32
+	}{
+		{"success", []int{200}, false, 1},
+		{"server error then success", []int{500, 502, 200}, false, 3},
+		{"too many requests then success", []int{429, 200}, false, 2},
+		{"server error exhausts retries", []int{503}, true, 3},
+		{"unauthorized not retried", []int{401}, true, 1},
+		{"forbidden not retried", []int{403}, true, 1},
+		{"not found not retried", []int{404}, true, 1},
+		{"bad request not retried", []int{400}, true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				n := int(attempts.Add(1)) - 1
+				status := tt.statuses[min(n, len(tt.statuses)-1)]
+				w.WriteHeader(status)
+				w.Write([]byte(`{"success":false,"message":"status"}`))
+			})
+
+			_, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestSendRequestStatusError(t *testing.T) {
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"success":false,"message":"Invalid API key"}`))
+	})
+
+	_, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "status 401") || !strings.Contains(err.Error(), "Invalid API key") {
+		t.Errorf("error %q does not include status and body", err)
+	}
+}
+
+func TestSendRequestTransportError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(okHandler))
+	srv.Close()
+
+	client := NewClientWithConfig("test_key", &Config{
+		BaseURL:    srv.URL,
+		MaxRetries: 1,
+		RetryDelay: time.Millisecond,
+	})
+	defer client.Close()
+
+	_, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "after 2 attempts") {
+		t.Errorf("err = %v, want failure after 2 attempts", err)
+	}
+}
+
+func TestSendRequestNonJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"html", "<html>Bad Gateway</html>"},
+		{"empty", ""},
+		{"truncated", `{"success":true,"data":`},
+		{"array", `[1,2,3]`},
+		{"null", `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.Write([]byte(tt.body))
+			})
+
+			_, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+			if err == nil || !strings.Contains(err.Error(), "failed to parse JSON response") {
+				t.Errorf("err = %v, want JSON parse error", err)
+			}
+			if got := attempts.Load(); got != 1 {
+				t.Errorf("attempts = %d, want 1", got)
+			}
+		})
+	}
+}
+
+func TestSendRequestContextCancelled(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		okHandler(w, r)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := NewClientWithConfig("test_key", &Config{BaseURL: srv.URL, Context: ctx})
+	defer client.Close()
+
+	_, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if got := attempts.Load(); got != 0 {
+		t.Errorf("attempts = %d, want 0", got)
+	}
+}
+
+func TestSendRequestContextCancelledDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	client := NewClientWithConfig("test_key", &Config{
+		BaseURL:    srv.URL,
+		MaxRetries: 3,
+		RetryDelay: time.Hour,
+		Context:    ctx,
+	})
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("sendRequest did not return after context was cancelled")
+	}
+}
+
+func TestSendRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	client := NewClientWithConfig("test_key", &Config{
+		BaseURL: srv.URL,
+		Timeout: 20 * time.Millisecond,
+	})
+	defer client.Close()
+
+	if _, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil); err == nil {
+		t.Error("expected timeout error")
+	}
+}
+
+func TestParamHelpers(t *testing.T) {
+	yes, no := true, false
+
+	params := make(map[string]string)
+	stringParam(params, "empty", "")
+	stringParam(params, "keyword", "go")
+	intParam(params, "start", Int(25))
+	intParam(params, "unsetInt", nil)
+	boolParam(params, "unset", nil)
+	boolParam(params, "yes", &yes)
+	boolParam(params, "no", &no)
+	sliceParam[string](params, "none", nil)
+	sliceParam(params, "ids", []string{"1", "2", "3"})
+
+	want := map[string]string{
+		"keyword": "go",
+		"start":   "25",
+		"yes":     "true",
+		"no":      "false",
+		"ids":     "1,2,3",
+	}
+	if len(params) != len(want) {
+		t.Errorf("params = %v, want %v", params, want)
+	}
+	for key, value := range want {
+		if params[key] != value {
+			t.Errorf("params[%q] = %q, want %q", key, params[key], value)
+		}
+	}
+}
+
+func TestKeyPoolFailover(t *testing.T) {
+	var used []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-linkdapi-apikey")
+		used = append(used, key)
+		switch key {
+		case "key_revoked":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"success":false,"message":"Invalid API key"}`))
+		case "key_broke":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"success":false,"message":"Insufficient credits"}`))
+		case "key_busy":
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			okHandler(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	pool := NewKeyPool([]string{"key_revoked", "key_broke", "key_busy", "key_good", ""}, nil)
+	client := NewClientWithConfig("", &Config{BaseURL: srv.URL, Keys: pool})
+	defer client.Close()
+
+	// Rejected and throttled keys are skipped within the same call, even without retries
+	for range 2 {
+		if _, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil); err != nil {
+			t.Fatalf("sendRequest: %v", err)
+		}
+	}
+	if want := []string{"key_revoked", "key_broke", "key_busy", "key_good", "key_good"}; strings.Join(used, ",") != strings.Join(want, ",") {
+		t.Errorf("keys used = %v, want %v", used, want)
+	}
+
+	stats := pool.Stats()
+	if len(stats) != 4 {
+		t.Fatalf("got %d key stats, want 4", len(stats))
+	}
+	checks := []struct {
+		removed   bool
+		reason    string
+		throttled int
+		successes int
+	}{
+		{true, "unauthorized", 0, 0},
+		{true, "insufficient credits", 0, 0},
+		{false, "", 1, 0},
+		{false, "", 0, 2},
+	}
+	for i, want := range checks {
+		got := stats[i]
+		if got.Removed != want.removed || got.Reason != want.reason || got.Throttled != want.throttled || got.Successes != want.successes {
+			t.Errorf("stats[%d] = %+v, want %+v", i, got, want)
+		}
+		if strings.Contains(got.Key, "key_") {
+			t.Errorf("stats[%d].Key = %q is not masked", i, got.Key)
+		}
+	}
+	if !stats[2].CooldownUntil.After(time.Now()) {
+		t.Errorf("throttled key has no cooldown: %v", stats[2].CooldownUntil)
+	}
+}
+
+func TestKeyPoolSelection(t *testing.T) {
+	next := func(p *KeyPool, n int) string {
+		var keys []string
+		for range n {
+			key, err := p.APIKey()
+			if err != nil {
+				t.Fatalf("APIKey: %v", err)
+			}
+			keys = append(keys, key)
+		}
+		return strings.Join(keys, ",")
+	}
+
+	if got := next(NewKeyPool([]string{"a", "b", "c"}, nil), 4); got != "a,b,c,a" {
+		t.Errorf("round robin = %s, want a,b,c,a", got)
+	}
+
+	least := NewKeyPool([]string{"a", "b"}, &KeyPoolOptions{Selection: LeastUsed})
+	next(least, 2)
+	least.Add("c")
+	if got := next(least, 3); got != "c,a,b" {
+		t.Errorf("least used = %s, want c,a,b", got)
+	}
+
+	quota := NewKeyPool([]string{"a", "b"}, &KeyPoolOptions{Quota: 1})
+	if got := next(quota, 2); got != "a,b" {
+		t.Errorf("quota = %s, want a,b", got)
+	}
+	if _, err := quota.APIKey(); !errors.Is(err, ErrNoKeys) {
+		t.Errorf("APIKey after quota err = %v, want ErrNoKeys", err)
+	}
+
+	// When every key is cooling down, the error names the first to recover
+	cooling := NewKeyPool([]string{"a", "b"}, &KeyPoolOptions{Cooldown: time.Hour})
+	cooling.Report("b", http.StatusTooManyRequests, "")
+	time.Sleep(time.Millisecond)
+	if cooling.Report("a", http.StatusTooManyRequests, "") {
+		t.Error("Report returned true with no usable key left")
+	}
+	var cooldown *CooldownError
+	if _, err := cooling.APIKey(); !errors.As(err, &cooldown) {
+		t.Fatalf("APIKey while cooling err = %v, want *CooldownError", err)
+	}
+	if want := cooling.Stats()[1].CooldownUntil; !cooldown.Until.Equal(want) {
+		t.Errorf("CooldownError.Until = %v, want b's cooldown %v", cooldown.Until, want)
+	}
+}
+
+func TestKeyPoolCooldownWait(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		okHandler(w, r)
+	}))
+	defer srv.Close()
+
+	// The only key is throttled, so the retry waits for its cooldown
+	pool := NewKeyPool([]string{"key_a"}, &KeyPoolOptions{Cooldown: 50 * time.Millisecond})
+	client := NewClientWithConfig("", &Config{BaseURL: srv.URL, Keys: pool, MaxRetries: 1, RetryDelay: time.Millisecond})
+	defer client.Close()
+
+	start := time.Now()
+	if _, err := client.GetProfileOverview("ryanroslansky"); err != nil {
+		t.Fatalf("GetProfileOverview: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("retried after %v, want it to wait out the 50ms cooldown", elapsed)
+	}
+
+	// Waiting gives up when the call's deadline passes
+	pool = NewKeyPool([]string{"key_a"}, &KeyPoolOptions{Cooldown: time.Hour})
+	pool.Report("key_a", http.StatusTooManyRequests, "")
+	client = NewClientWithConfig("", &Config{BaseURL: srv.URL, Keys: pool})
+	defer client.Close()
+
+	start = time.Now()
+	if _, err := client.GetProfileOverview("ryanroslansky", WithTimeout(20*time.Millisecond)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("gave up after %v, want about 20ms", elapsed)
+	}
+}
+
+func TestCredentialsProviders(t *testing.T) {
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("X-linkdapi-apikey"))
+		okHandler(w, r)
+	}))
+	defer srv.Close()
+
+	// The environment is read before every request
+	t.Setenv(EnvAPIKey, "")
+	client := NewClientWithConfig("ignored", &Config{BaseURL: srv.URL, Credentials: EnvCredentials{}})
+	defer client.Close()
+	if _, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil); !errors.Is(err, ErrNoKeys) {
+		t.Errorf("unset env err = %v, want ErrNoKeys", err)
+	}
+	t.Setenv(EnvAPIKey, "env_one")
+	client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+	t.Setenv(EnvAPIKey, " env_two\n")
+	client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+
+	// A rewritten file is picked up; a missing one keeps the last key
+	path := filepath.Join(t.TempDir(), "key")
+	if _, err := NewFileCredentials(path, 0); err == nil {
+		t.Error("NewFileCredentials with missing file: want error")
+	}
+	if err := os.WriteFile(path, []byte("file_one\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	creds, err := NewFileCredentials(path, time.Nanosecond)
+	if err != nil {
+		t.Fatalf("NewFileCredentials: %v", err)
+	}
+	client = NewClientWithConfig("", &Config{BaseURL: srv.URL, Credentials: creds})
+	defer client.Close()
+	client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+	if err := os.WriteFile(path, []byte("file_two_rotated"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+	os.Remove(path)
+	client.sendRequest("GET", "api/v1/profile/overview", nil, nil)
+
+	want := "env_one,env_two,file_one,file_two_rotated,file_two_rotated"
+	if got := strings.Join(keys, ","); got != want {
+		t.Errorf("keys sent = %s, want %s", got, want)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	for _, name := range []string{EnvAPIKey, EnvAPIKeyFile, EnvBaseURL, EnvTimeout, EnvMaxRetries, EnvRetryDelay, EnvRateLimit, EnvResolverCacheFile} {
+		t.Setenv(name, "")
+	}
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	path := write("config.json", `{"base_url": "http://localhost:8080", "timeout": "5s", "max_retries": 1, "rate_limit": 2.5, "api_key": "file_key"}`)
+	t.Setenv(EnvMaxRetries, "7")
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	// Unset fields keep their defaults and the environment wins over the file
+	if config.BaseURL != "http://localhost:8080" || config.Timeout != 5*time.Second || config.MaxRetries != 7 ||
+		config.RetryDelay != time.Second || config.RateLimit != 2.5 {
+		t.Errorf("LoadConfig = %+v", config)
+	}
+	if key, _ := config.Credentials.APIKey(); key != "file_key" {
+		t.Errorf("api key = %q, want file_key", key)
+	}
+
+	t.Setenv(EnvAPIKey, "env_key")
+	config, err = ConfigFromEnv()
+	if err != nil {
+		t.Fatalf("ConfigFromEnv: %v", err)
+	}
+	if key, _ := config.Credentials.APIKey(); key != "env_key" || config.MaxRetries != 7 || config.BaseURL != DefaultConfig().BaseURL {
+		t.Errorf("ConfigFromEnv = %+v, key %q", config, key)
+	}
+	t.Setenv(EnvMaxRetries, "")
+
+	invalid := []struct {
+		name, file, env, value string
+	}{
+		{"negative retries", `{"max_retries": -1}`, "", ""},
+		{"negative timeout", `{"timeout": "-1s"}`, "", ""},
+		{"numeric duration", `{"retry_delay": 5}`, "", ""},
+		{"unknown field", `{"retries": 3}`, "", ""},
+		{"relative base url", `{"base_url": "linkdapi.com"}`, "", ""},
+		{"both keys", `{"api_key": "a", "api_key_file": "b"}`, "", ""},
+		{"bad env retries", `{}`, EnvMaxRetries, "three"},
+		{"negative env rate", `{}`, EnvRateLimit, "-2"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.env != "" {
+				t.Setenv(tt.env, tt.value)
+			}
+			if _, err := LoadConfig(write("invalid.json", tt.file)); !errors.Is(err, ErrInvalidConfig) {
+				t.Errorf("LoadConfig err = %v, want ErrInvalidConfig", err)
+			}
+		})
+	}
+
+	if _, err := LoadConfig(filepath.Join(dir, "missing.json")); err == nil || errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("LoadConfig missing file err = %v", err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(okHandler))
+	defer srv.Close()
+
+	client := NewClientWithConfig("key", &Config{BaseURL: srv.URL, RateLimit: 50})
+	defer client.Close()
+
+	start := time.Now()
+	for range 4 {
+		if _, err := client.sendRequest("GET", "api/v1/profile/overview", nil, nil); err != nil {
+			t.Fatalf("sendRequest: %v", err)
+		}
+	}
+	// The first request goes out at once, the other three 20ms apart
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("4 requests at 50/s took %v, want at least 60ms", elapsed)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	var sent int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		switch r.URL.Path {
+		case "/api/v1/profile/overview", "/api/v1/posts/info", "/api/v1/jobs/search":
+			okHandler(w, r)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClientWithConfig("key", &Config{BaseURL: srv.URL, Budget: 4})
+	defer client.Close()
+
+	for _, endpoint := range []string{"api/v1/profile/overview", "/api/v1/posts/info", "api/v1/missing", "api/v1/jobs/search"} {
+		client.sendRequest("GET", endpoint, nil, nil)
+	}
+	usage := client.Usage()
+	if usage.Requests != 4 || usage.Billable != 3 || usage.Credits != 3 || usage.Budget != 4 {
+		t.Errorf("Usage = %+v", usage)
+	}
+	want := map[string]EndpointUsage{
+		"api/v1/profile/overview": {Requests: 1, Billable: 1, Credits: 1},
+		"api/v1/posts/info":       {Requests: 1, Billable: 1, Credits: 1},
+		"api/v1/missing":          {Requests: 1},
+		"api/v1/jobs/search":      {Requests: 1, Billable: 1, Credits: 1},
+	}
+	for endpoint, w := range want {
+		if got := usage.Endpoints[endpoint]; got != w {
+			t.Errorf("Endpoints[%s] = %+v, want %+v", endpoint, got, w)
+		}
+	}
+
+	// The snapshot is a copy
+	usage.Endpoints["api/v1/jobs/search"] = EndpointUsage{}
+	if client.Usage().Endpoints["api/v1/jobs/search"].Billable != 1 {
+		t.Error("modifying the snapshot changed the client's usage")
+	}
+
+	// The budget is spent after one more call; the next fails without a request
+	if _, err := client.sendRequest("GET", "api/v1/jobs/search", nil, nil); err != nil {
+		t.Fatalf("sendRequest within budget: %v", err)
+	}
+	if _, err := client.sendRequest("GET", "api/v1/jobs/search", nil, nil); !errors.Is(err, ErrBudgetExceeded) {
+		t.Errorf("sendRequest over budget err = %v, want ErrBudgetExceeded", err)
+	}
+	if sent != 5 {
+		t.Errorf("%d requests sent, want 5", sent)
+	}
+}
+
+func TestBudgetConcurrent(t *testing.T) {
+	var sent atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent.Add(1)
+		time.Sleep(20 * time.Millisecond)
+		okHandler(w, r)
+	}))
+	defer srv.Close()
+
+	client := NewClientWithConfig("key", &Config{BaseURL: srv.URL, Budget: 3})
+	defer client.Close()
+
+	// Requests in flight hold their credit, so parallel calls cannot overshoot
+	errs := make(chan error, 10)
+	for range 10 {
+		go func() {
+			_, err := client.sendRequest("GET", "api/v1/jobs/search", nil, nil)
+			errs <- err
+		}()
+	}
+	exceeded := 0
+	for range 10 {
+		if err := <-errs; errors.Is(err, ErrBudgetExceeded) {
+			exceeded++
+		} else if err != nil {
+			t.Errorf("sendRequest: %v", err)
+		}
+	}
+	if n := sent.Load(); n != 3 || exceeded != 7 {
+		t.Errorf("%d requests sent and %d over budget, want 3 and 7", n, exceeded)
+	}
+	if usage := client.Usage(); usage.Credits != 3 {
+		t.Errorf("Credits = %v, want 3", usage.Credits)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	square := func(n int) (int, error) {
+		if n < 0 {
+			return 0, fmt.Errorf("negative %d", n)
+		}
+		time.Sleep(time.Duration(10-n) * time.Millisecond)
+		return n * n, nil
+	}
+
+	var progress []int
+	results, err := Batch(context.Background(), []int{1, -2, 3, 4, -5}, square, &BatchOptions{
+		Workers:  3,
+		Progress: func(done, total int) { progress = append(progress, done*10+total) },
+	})
+	if err == nil || !strings.Contains(err.Error(), "negative -2") || !strings.Contains(err.Error(), "negative -5") {
+		t.Errorf("err = %v, want both failures joined", err)
+	}
+	for i, want := range []int{1, 0, 9, 16, 0} {
+		r := results[i]
+		if r.Value != want || (r.Err != nil) != (r.Item < 0) {
+			t.Errorf("results[%d] = %+v, want value %d", i, r, want)
+		}
+	}
+	if fmt.Sprint(progress) != "[15 25 35 45 55]" {
+		t.Errorf("progress = %v", progress)
+	}
+
+	// Fail-fast stops starting new items, even one already handed to a
+	// worker while the failing item was still running
+	var ran atomic.Int32
+	results, err = Batch(context.Background(), []int{-1, 2, 3, 4}, func(n int) (int, error) {
+		ran.Add(1)
+		if n < 0 {
+			time.Sleep(5 * time.Millisecond)
+		}
+		return square(n)
+	}, &BatchOptions{Workers: 1, FailFast: true})
+	if err == nil || errors.Is(err, ErrBatchSkipped) || ran.Load() != 1 {
+		t.Errorf("fail-fast err = %v after %d items", err, ran.Load())
+	}
+	for _, r := range results[1:] {
+		if !errors.Is(r.Err, ErrBatchSkipped) {
+			t.Errorf("item %d err = %v, want ErrBatchSkipped", r.Item, r.Err)
+		}
+	}
+
+	// A done context stops the batch
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cancelled, err := Batch(ctx, []string{"a", "b"}, func(s string) (string, error) { return s, nil }, nil)
+	if !errors.Is(err, context.Canceled) || !errors.Is(cancelled[1].Err, context.Canceled) {
+		t.Errorf("cancelled err = %v, results = %+v", err, cancelled)
+	}
+
+	if results, err := Batch(context.Background(), []int(nil), square, nil); err != nil || len(results) != 0 {
+		t.Errorf("empty batch = %v, %v", results, err)
+	}
+}
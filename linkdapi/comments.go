@@ -5,17 +5,42 @@ package linkdapi
 // GetAllComments retrieves all comments made by a profile using their URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/comments/all
-func (c *Client) GetAllComments(urn string, cursor string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	stringParam(params, "cursor", cursor)
-	return c.sendRequest("GET", "api/v1/comments/all", params)
+func (c *Client) GetAllComments(urn string, cursor string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsCursor)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	stringParam(params, "cursor", o.cursorOr(cursor))
+	return c.sendRequest("GET", "api/v1/comments/all", params, o)
 }
 
 // GetCommentLikes gets all users who reacted to one or more comment URNs.
+// urns is a comma-separated list of comment URNs in any form accepted by ParseCommentURN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/comments/likes
-func (c *Client) GetCommentLikes(urns string, start int) (map[string]any, error) {
-	params := map[string]string{"urn": urns}
-	intParam(params, "start", start)
-	return c.sendRequest("GET", "api/v1/comments/likes", params)
+func (c *Client) GetCommentLikes(urns string, start int, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string)
+	if err := startParam(params, o.startOr(start)); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, urn := range SplitURNs(urns) {
+		commentURN, err := ParseCommentURN(urn)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, commentURN.String())
+	}
+	sliceParam(params, "urn", ids)
+	return c.sendRequest("GET", "api/v1/comments/likes", params, o)
 }
@@ -7,75 +7,143 @@ import "fmt"
 // CompanyNameLookup searches companies by name.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/companies/name-lookup
-func (c *Client) CompanyNameLookup(query string) (map[string]any, error) {
+func (c *Client) CompanyNameLookup(query string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
 	params := map[string]string{"query": query}
-	return c.sendRequest("GET", "api/v1/companies/name-lookup", params)
+	return c.sendRequest("GET", "api/v1/companies/name-lookup", params, o)
 }
 
 // GetCompanyInfo gets company details either by ID or name.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/companies/company/info
-func (c *Client) GetCompanyInfo(companyID, name string) (map[string]any, error) {
+func (c *Client) GetCompanyInfo(companyID, name string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
 	if companyID == "" && name == "" {
 		return nil, fmt.Errorf("either companyID or name must be provided")
 	}
 
 	params := make(map[string]string)
-	stringParam(params, "id", companyID)
+	if companyID != "" {
+		id, err := ParseCompanyID(companyID)
+		if err != nil {
+			return nil, err
+		}
+		params["id"] = id.String()
+	}
 	stringParam(params, "name", name)
 
-	return c.sendRequest("GET", "api/v1/companies/company/info", params)
+	return c.sendRequest("GET", "api/v1/companies/company/info", params, o)
 }
 
 // GetSimilarCompanies gets similar companies by ID.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/companies/company/similar
-func (c *Client) GetSimilarCompanies(companyID string) (map[string]any, error) {
-	params := map[string]string{"id": companyID}
-	return c.sendRequest("GET", "api/v1/companies/company/similar", params)
+func (c *Client) GetSimilarCompanies(companyID string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.resolver.companyID(o, companyID)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"id": id.String()}
+	return c.sendRequest("GET", "api/v1/companies/company/similar", params, o)
 }
 
 // GetCompanyEmployeesData gets company employees data by ID.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/companies/company/employees-data
-func (c *Client) GetCompanyEmployeesData(companyID string) (map[string]any, error) {
-	params := map[string]string{"id": companyID}
-	return c.sendRequest("GET", "api/v1/companies/company/employees-data", params)
+func (c *Client) GetCompanyEmployeesData(companyID string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.resolver.companyID(o, companyID)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"id": id.String()}
+	return c.sendRequest("GET", "api/v1/companies/company/employees-data", params, o)
 }
 
 // GetCompanyJobs gets available job listings for given companies by ID.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/companies/jobs
-func (c *Client) GetCompanyJobs(companyIDs []string, start int) (map[string]any, error) {
+func (c *Client) GetCompanyJobs(companyIDs []string, start int, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart)
+	if err != nil {
+		return nil, err
+	}
 	params := make(map[string]string)
-	sliceParam(params, "companyIDs", companyIDs)
-	intParam(params, "start", start)
-	return c.sendRequest("GET", "api/v1/companies/jobs", params)
+	if err := startParam(params, o.startOr(start)); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(companyIDs))
+	for _, companyID := range companyIDs {
+		id, err := c.resolver.companyID(o, companyID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id.String())
+	}
+	sliceParam(params, "companyIDs", ids)
+	return c.sendRequest("GET", "api/v1/companies/jobs", params, o)
 }
 
 // GetCompanyAffiliatedPages gets affiliated pages/subsidiaries of a company by ID.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/companies/company/affiliated-pages
-func (c *Client) GetCompanyAffiliatedPages(companyID string) (map[string]any, error) {
-	params := map[string]string{"id": companyID}
-	return c.sendRequest("GET", "api/v1/companies/company/affiliated-pages", params)
+func (c *Client) GetCompanyAffiliatedPages(companyID string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.resolver.companyID(o, companyID)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"id": id.String()}
+	return c.sendRequest("GET", "api/v1/companies/company/affiliated-pages", params, o)
 }
 
 // GetCompanyPosts gets posts of a company by ID.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/companies/company/posts
-func (c *Client) GetCompanyPosts(companyID string, start int) (map[string]any, error) {
-	params := map[string]string{"id": companyID}
-	intParam(params, "start", start)
-	return c.sendRequest("GET", "api/v1/companies/company/posts", params)
+func (c *Client) GetCompanyPosts(companyID string, start int, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string)
+	if err := startParam(params, o.startOr(start)); err != nil {
+		return nil, err
+	}
+	id, err := c.resolver.companyID(o, companyID)
+	if err != nil {
+		return nil, err
+	}
+	params["id"] = id.String()
+	return c.sendRequest("GET", "api/v1/companies/company/posts", params, o)
 }
 
 // GetCompanyID gets ID of a company by universal_name (username).
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/companies/company/universal-name-to-id
-func (c *Client) GetCompanyID(universalName string) (map[string]any, error) {
+func (c *Client) GetCompanyID(universalName string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
 	params := map[string]string{"universalName": universalName}
-	return c.sendRequest("GET", "api/v1/companies/company/universal-name-to-id", params)
+	return c.sendRequest("GET", "api/v1/companies/company/universal-name-to-id", params, o)
 }
 
 // GetCompanyDetailsV2 gets company details V2 with extended information by company ID.
@@ -83,7 +151,15 @@ func (c *Client) GetCompanyID(universalName string) (map[string]any, error) {
 // peopleAlsoFollow, affiliatedByJobs, etc.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/companies/company/info-v2
-func (c *Client) GetCompanyDetailsV2(companyID string) (map[string]any, error) {
-	params := map[string]string{"id": companyID}
-	return c.sendRequest("GET", "api/v1/companies/company/info-v2", params)
+func (c *Client) GetCompanyDetailsV2(companyID string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.resolver.companyID(o, companyID)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"id": id.String()}
+	return c.sendRequest("GET", "api/v1/companies/company/info-v2", params, o)
 }
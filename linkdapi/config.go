@@ -1,10 +1,23 @@
 package linkdapi
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// ErrInvalidConfig is matched by errors from LoadConfig, ConfigFromEnv and
+// Config.Validate for settings that cannot be used.
+var ErrInvalidConfig = errors.New("invalid config")
+
 // Config holds configuration options for the LinkdAPI client.
 type Config struct {
 	// BaseURL is the base URL for the API (default: "https://linkdapi.com")
@@ -20,9 +33,33 @@ type Config struct {
 	// Note: Delay increases exponentially with each retry
 	RetryDelay time.Duration
 
+	// RateLimit is the maximum number of requests sent per second, 0 for unlimited (default: 0)
+	// Requests are spaced evenly, across retries and goroutines sharing the client
+	RateLimit float64
+
+	// Budget is the number of credits the client may spend, 0 for unlimited (default: 0)
+	// Once spent, calls fail with ErrBudgetExceeded without sending a request; see Client.Usage
+	Budget float64
+
 	// Context is the context to use for all requests (default: context.Background())
 	// Set this if you need custom timeout or cancellation behavior
 	Context context.Context
+
+	// Transport is the HTTP transport used to send requests (default: a pooled http.Transport)
+	// Set this to record, replay or otherwise intercept traffic
+	Transport http.RoundTripper
+
+	// Credentials supplies the API key before every request (default: the key passed to NewClientWithConfig)
+	// Set this to EnvCredentials or a FileCredentials to pick up rotated keys without a restart
+	Credentials CredentialsProvider
+
+	// Keys supplies the API key for each request and takes precedence over Credentials
+	// Set this to a KeyPool to spread requests across several keys
+	Keys KeyProvider
+
+	// ResolverCacheFile is a JSON file in which resolved usernames and company
+	// names are persisted across runs (default: "", in memory only)
+	ResolverCacheFile string
 }
 
 // DefaultConfig returns a Config with default values.
@@ -34,3 +71,201 @@ func DefaultConfig() *Config {
 		RetryDelay: 1 * time.Second,
 	}
 }
+
+// Environment variables read by ConfigFromEnv and LoadConfig. Durations use
+// Go syntax, e.g. "30s" or "1m30s".
+const (
+	EnvBaseURL           = "LINKDAPI_BASE_URL"
+	EnvTimeout           = "LINKDAPI_TIMEOUT"
+	EnvMaxRetries        = "LINKDAPI_MAX_RETRIES"
+	EnvRetryDelay        = "LINKDAPI_RETRY_DELAY"
+	EnvRateLimit         = "LINKDAPI_RATE_LIMIT"
+	EnvBudget            = "LINKDAPI_BUDGET"
+	EnvResolverCacheFile = "LINKDAPI_RESOLVER_CACHE_FILE"
+	EnvAPIKeyFile        = "LINKDAPI_API_KEY_FILE"
+)
+
+// ConfigFromEnv returns DefaultConfig overridden by the LINKDAPI_* environment
+// variables that are set. If LINKDAPI_API_KEY is set, the key is read from it
+// before every request; if LINKDAPI_API_KEY_FILE is set, from that file.
+//
+// Example:
+//
+//	config, err := linkdapi.ConfigFromEnv()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	client := linkdapi.NewClientWithConfig("", config)
+func ConfigFromEnv() (*Config, error) {
+	config := DefaultConfig()
+	if err := config.applyEnv(); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// LoadConfig reads a JSON config file over DefaultConfig, then applies the
+// environment like ConfigFromEnv, so the environment wins. Unknown fields are
+// rejected to catch typos. All fields are optional:
+//
+//	{
+//	    "base_url": "https://linkdapi.com",
+//	    "timeout": "30s",
+//	    "max_retries": 3,
+//	    "retry_delay": "1s",
+//	    "rate_limit": 5,
+//	    "budget": 10000,
+//	    "resolver_cache_file": "linkdapi-ids.json",
+//	    "api_key_file": "/var/run/secrets/linkdapi/api-key"
+//	}
+//
+// "api_key" may be given instead of "api_key_file".
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var file struct {
+		BaseURL           *string   `json:"base_url"`
+		Timeout           *duration `json:"timeout"`
+		MaxRetries        *int      `json:"max_retries"`
+		RetryDelay        *duration `json:"retry_delay"`
+		RateLimit         *float64  `json:"rate_limit"`
+		Budget            *float64  `json:"budget"`
+		ResolverCacheFile *string   `json:"resolver_cache_file"`
+		APIKey            *string   `json:"api_key"`
+		APIKeyFile        *string   `json:"api_key_file"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidConfig, path, err)
+	}
+
+	config := DefaultConfig()
+	if file.BaseURL != nil {
+		config.BaseURL = *file.BaseURL
+	}
+	if file.Timeout != nil {
+		config.Timeout = time.Duration(*file.Timeout)
+	}
+	if file.MaxRetries != nil {
+		config.MaxRetries = *file.MaxRetries
+	}
+	if file.RetryDelay != nil {
+		config.RetryDelay = time.Duration(*file.RetryDelay)
+	}
+	if file.RateLimit != nil {
+		config.RateLimit = *file.RateLimit
+	}
+	if file.Budget != nil {
+		config.Budget = *file.Budget
+	}
+	if file.ResolverCacheFile != nil {
+		config.ResolverCacheFile = *file.ResolverCacheFile
+	}
+	switch {
+	case file.APIKey != nil && file.APIKeyFile != nil:
+		return nil, fmt.Errorf("%w: %s: api_key and api_key_file are mutually exclusive", ErrInvalidConfig, path)
+	case file.APIKey != nil:
+		config.Credentials = staticKey(strings.TrimSpace(*file.APIKey))
+	case file.APIKeyFile != nil:
+		if config.Credentials, err = NewFileCredentials(*file.APIKeyFile, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := config.applyEnv(); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate reports settings that cannot be used, such as negative retries or
+// a base URL without a scheme.
+func (c *Config) Validate() error {
+	var problems []string
+	if u, err := url.Parse(c.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		problems = append(problems, fmt.Sprintf("BaseURL %q must be an http or https URL", c.BaseURL))
+	}
+	if c.Timeout < 0 {
+		problems = append(problems, fmt.Sprintf("Timeout %v must not be negative", c.Timeout))
+	}
+	if c.MaxRetries < 0 {
+		problems = append(problems, fmt.Sprintf("MaxRetries %d must not be negative", c.MaxRetries))
+	}
+	if c.RetryDelay < 0 {
+		problems = append(problems, fmt.Sprintf("RetryDelay %v must not be negative", c.RetryDelay))
+	}
+	if c.Budget < 0 {
+		problems = append(problems, fmt.Sprintf("Budget %v must not be negative", c.Budget))
+	}
+	if c.RateLimit < 0 {
+		problems = append(problems, fmt.Sprintf("RateLimit %v must not be negative", c.RateLimit))
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// applyEnv overrides c with the LINKDAPI_* environment variables that are set.
+func (c *Config) applyEnv() error {
+	var problems []string
+	lookup := func(name string) (string, bool) {
+		value := strings.TrimSpace(os.Getenv(name))
+		return value, value != ""
+	}
+	parse := func(name string, set func(string) error) {
+		if value, ok := lookup(name); ok {
+			if err := set(value); err != nil {
+				problems = append(problems, fmt.Sprintf("%s %q is not valid", name, value))
+			}
+		}
+	}
+
+	parse(EnvBaseURL, func(v string) error { c.BaseURL = v; return nil })
+	parse(EnvTimeout, func(v string) (err error) { c.Timeout, err = time.ParseDuration(v); return })
+	parse(EnvMaxRetries, func(v string) (err error) { c.MaxRetries, err = strconv.Atoi(v); return })
+	parse(EnvRetryDelay, func(v string) (err error) { c.RetryDelay, err = time.ParseDuration(v); return })
+	parse(EnvRateLimit, func(v string) (err error) { c.RateLimit, err = strconv.ParseFloat(v, 64); return })
+	parse(EnvBudget, func(v string) (err error) { c.Budget, err = strconv.ParseFloat(v, 64); return })
+	parse(EnvResolverCacheFile, func(v string) error { c.ResolverCacheFile = v; return nil })
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(problems, "; "))
+	}
+
+	if _, ok := lookup(EnvAPIKey); ok {
+		c.Credentials = EnvCredentials{}
+	} else if path, ok := lookup(EnvAPIKeyFile); ok {
+		creds, err := NewFileCredentials(path, 0)
+		if err != nil {
+			return err
+		}
+		c.Credentials = creds
+	}
+	return nil
+}
+
+// duration is a time.Duration read from a JSON string such as "30s".
+type duration time.Duration
+
+func (d *duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"30s\"")
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(v)
+	return nil
+}
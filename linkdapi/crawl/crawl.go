@@ -0,0 +1,266 @@
+// Package crawl walks the "similar" relations between profiles or companies
+// breadth-first, building a graph of the neighborhood around a set of seeds,
+// e.g. for lookalike audiences.
+//
+// Basic usage:
+//
+//	g, err := crawl.Profiles(client, []string{"ryanroslansky"}, &crawl.Options{
+//	    MaxDepth: 2,
+//	    MaxNodes: 200,
+//	})
+//	if err != nil {
+//	    log.Printf("partial graph: %v", err)
+//	}
+//	for _, n := range g.Nodes {
+//	    fmt.Println(n.Depth, n.ID, n.Name)
+//	}
+//	g.WriteDOT(os.Stdout)
+//
+// Nodes that fail to expand are kept in the graph without neighbors and
+// reported in the returned error.
+package crawl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/internal/apiutil"
+)
+
+// API is the part of the LinkdAPI client the crawler uses. *linkdapi.Client
+// and the linkdapimock.Client implement it.
+type API interface {
+	linkdapi.ProfileAPI
+	linkdapi.CompanyAPI
+}
+
+// Kind is the kind of entity a Node is.
+type Kind string
+
+const (
+	KindProfile Kind = "profile"
+	KindCompany Kind = "company"
+)
+
+// Node is a profile or company in the graph.
+type Node struct {
+	ID    string // Profile URN or company ID
+	Kind  Kind
+	Name  string         // Display name, if known
+	Depth int            // Hops from the nearest seed; seeds are at depth 0
+	Data  map[string]any // The item describing the node in its parent's similar list; nil for seeds
+}
+
+// Edge is a "similar" relation from one node to another.
+type Edge struct {
+	From string
+	To   string
+}
+
+// Options configures a crawl.
+type Options struct {
+	// MaxDepth is the number of hops from the seeds to explore (default: 2)
+	MaxDepth int
+
+	// MaxNodes is the largest number of nodes in the graph, seeds included (default: 100)
+	MaxNodes int
+
+	// Workers is the number of nodes expanded in parallel (default: 4)
+	Workers int
+
+	// Filter, if set, decides whether a discovered node is added to the graph
+	// and explored further. Seeds are always added.
+	Filter func(Node) bool
+
+	// Context, if set, stops the crawl when done, aborting requests in
+	// flight; the graph built so far is returned
+	Context context.Context
+}
+
+// Profiles crawls GetSimilarProfiles from seed usernames or profile URNs.
+// Usernames are resolved to URNs with the client's Resolver, so lookups are
+// cached and shared with other calls. Without a Resolver, as with a
+// linkdapimock.Client, seeds must be URNs.
+func Profiles(api API, seeds []string, opts *Options) (*Graph, error) {
+	c := crawler{
+		kind: KindProfile,
+		resolve: func(ctx context.Context, seeds []string, workers int) (map[string]string, error) {
+			if r := resolverOf(api); r != nil {
+				return stringMap(r.ResolveProfiles(seeds, workers, linkdapi.WithContext(ctx)))
+			}
+			return parseAll(seeds, linkdapi.ParseProfileURN)
+		},
+		similar: api.GetSimilarProfiles,
+		parse: func(item map[string]any) (string, string) {
+			var id string
+			for _, key := range []string{"urn", "profileUrn", "profileURN", "entityUrn"} {
+				if urn, err := linkdapi.ParseProfileURN(apiutil.String(item[key])); err == nil {
+					id = urn.String()
+					break
+				}
+			}
+			name := apiutil.First(item, "fullName", "name")
+			if name == "" {
+				name = strings.TrimSpace(apiutil.First(item, "firstName") + " " + apiutil.First(item, "lastName"))
+			}
+			return id, name
+		},
+	}
+	return c.run(seeds, opts)
+}
+
+// Companies crawls GetSimilarCompanies from seed company IDs, URNs or
+// universal names. Universal names are resolved to IDs with the client's
+// Resolver, so lookups are cached and shared with other calls. Without a
+// Resolver, as with a linkdapimock.Client, seeds must be IDs or URNs.
+func Companies(api API, seeds []string, opts *Options) (*Graph, error) {
+	c := crawler{
+		kind: KindCompany,
+		resolve: func(ctx context.Context, seeds []string, workers int) (map[string]string, error) {
+			if r := resolverOf(api); r != nil {
+				return stringMap(r.ResolveCompanies(seeds, workers, linkdapi.WithContext(ctx)))
+			}
+			return parseAll(seeds, linkdapi.ParseCompanyID)
+		},
+		similar: api.GetSimilarCompanies,
+		parse: func(item map[string]any) (string, string) {
+			var id string
+			for _, key := range []string{"id", "companyId", "urn", "entityUrn"} {
+				if parsed, err := linkdapi.ParseCompanyID(apiutil.String(item[key])); err == nil {
+					id = parsed.String()
+					break
+				}
+			}
+			return id, apiutil.First(item, "name", "companyName")
+		},
+	}
+	return c.run(seeds, opts)
+}
+
+// resolverOf returns the Resolver of api if it has one, as *linkdapi.Client
+// does, or nil.
+func resolverOf(api API) *linkdapi.Resolver {
+	if r, ok := api.(interface{ Resolver() *linkdapi.Resolver }); ok {
+		return r.Resolver()
+	}
+	return nil
+}
+
+// stringMap formats the values of a ResolveProfiles or ResolveCompanies
+// result.
+func stringMap[T fmt.Stringer](ids map[string]T, err error) (map[string]string, error) {
+	out := make(map[string]string, len(ids))
+	for seed, id := range ids {
+		out[seed] = id.String()
+	}
+	return out, err
+}
+
+// parseAll maps each seed that parse accepts to its formatted ID, joining
+// the errors of the others.
+func parseAll[T fmt.Stringer](seeds []string, parse func(string) (T, error)) (map[string]string, error) {
+	out := make(map[string]string, len(seeds))
+	var errs []error
+	for _, seed := range seeds {
+		id, err := parse(seed)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		out[seed] = id.String()
+	}
+	return out, errors.Join(errs...)
+}
+
+// crawler holds what differs between profile and company crawls.
+type crawler struct {
+	kind    Kind
+	resolve func(ctx context.Context, seeds []string, workers int) (map[string]string, error) // Seeds to node IDs
+	similar func(id string, opts ...linkdapi.RequestOption) (map[string]any, error)           // Similar list of a node
+	parse   func(item map[string]any) (id, name string)                                       // Node ID and name of a list item
+}
+
+func (c crawler) run(seeds []string, opts *Options) (*Graph, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	maxDepth, maxNodes, workers := opts.MaxDepth, opts.MaxNodes, opts.Workers
+	if maxDepth <= 0 {
+		maxDepth = 2
+	}
+	if maxNodes <= 0 {
+		maxNodes = 100
+	}
+	if workers <= 0 {
+		workers = 4
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	g := newGraph()
+	var errs []error
+	ids, err := c.resolve(ctx, seeds, workers)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("crawl: resolving seeds: %w", err))
+	}
+	var frontier []string
+	for _, seed := range seeds {
+		id, ok := ids[seed]
+		if !ok {
+			continue
+		}
+		if len(g.Nodes) >= maxNodes {
+			break
+		}
+		if g.add(Node{ID: id, Kind: c.kind}) {
+			frontier = append(frontier, id)
+		}
+	}
+
+	for depth := 1; depth <= maxDepth && len(frontier) > 0 && ctx.Err() == nil; depth++ {
+		results, _ := linkdapi.Batch(ctx, frontier, func(id string) ([]map[string]any, error) {
+			resp, err := c.similar(id, linkdapi.WithContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+			return apiutil.Items(resp, "profiles", "companies", "similar")
+		}, &linkdapi.BatchOptions{Workers: workers})
+
+		// Results are in frontier order, so the graph does not depend on timing
+		var next []string
+		for _, r := range results {
+			if r.Err != nil {
+				if !errors.Is(r.Err, ctx.Err()) {
+					errs = append(errs, fmt.Errorf("crawl: expanding %s: %w", r.Item, r.Err))
+				}
+				continue
+			}
+			for _, item := range r.Value {
+				id, name := c.parse(item)
+				if id == "" || id == r.Item {
+					continue
+				}
+				if _, known := g.index[id]; !known {
+					n := Node{ID: id, Kind: c.kind, Name: name, Depth: depth, Data: item}
+					if len(g.Nodes) >= maxNodes || opts.Filter != nil && !opts.Filter(n) {
+						continue
+					}
+					g.add(n)
+					next = append(next, id)
+				}
+				g.link(r.Item, id)
+			}
+		}
+		// A full graph has no room for the next level's discoveries
+		if len(g.Nodes) >= maxNodes {
+			next = nil
+		}
+		frontier = next
+	}
+	return g, errors.Join(errs...)
+}
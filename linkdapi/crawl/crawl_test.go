@@ -0,0 +1,189 @@
+package crawl
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapimock"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapitest"
+)
+
+// similar is a graph of similar companies: 1 -> 2, 3; 2 -> 4; 3 -> 4, 5; 4 -> 6.
+var similar = map[string][]string{
+	"1": {"2", "3"},
+	"2": {"4"},
+	"3": {"4", "5"},
+	"4": {"6"},
+}
+
+// companies answers GetSimilarCompanies from similar.
+func companies() *linkdapimock.Client {
+	mock := &linkdapimock.Client{}
+	mock.GetSimilarCompaniesFunc = func(id string, _ ...linkdapi.RequestOption) (map[string]any, error) {
+		var items []any
+		for _, s := range similar[id] {
+			items = append(items, map[string]any{"id": s, "name": "Company " + s})
+		}
+		return map[string]any{"success": true, "data": map[string]any{"companies": items}}, nil
+	}
+	return mock
+}
+
+func nodeIDs(g *Graph) []string {
+	var ids []string
+	for _, n := range g.Nodes {
+		ids = append(ids, n.ID)
+	}
+	return ids
+}
+
+func TestCompaniesLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     Options
+		want     []string
+		expanded int // GetSimilarCompanies calls
+	}{
+		{"depth 1", Options{MaxDepth: 1}, []string{"1", "2", "3"}, 1},
+		{"default depth 2", Options{}, []string{"1", "2", "3", "4", "5"}, 3},
+		{"depth 3", Options{MaxDepth: 3}, []string{"1", "2", "3", "4", "5", "6"}, 5},
+		{"MaxNodes stops expanding", Options{MaxDepth: 3, MaxNodes: 3}, []string{"1", "2", "3"}, 1},
+		{"MaxNodes mid-level", Options{MaxDepth: 3, MaxNodes: 4}, []string{"1", "2", "3", "4"}, 3},
+		{"Filter", Options{MaxDepth: 3, Filter: func(n Node) bool { return n.ID != "3" }}, []string{"1", "2", "4", "6"}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := companies()
+			g, err := Companies(mock, []string{"1"}, &tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := nodeIDs(g); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("nodes = %v, want %v", got, tt.want)
+			}
+			if n := len(mock.GetSimilarCompaniesCalls()); n != tt.expanded {
+				t.Errorf("expanded %d nodes, want %d", n, tt.expanded)
+			}
+		})
+	}
+}
+
+func TestCompaniesDeterministic(t *testing.T) {
+	var want string
+	for i := 0; i < 10; i++ {
+		g, err := Companies(companies(), []string{"1"}, &Options{MaxDepth: 3, Workers: 8})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b strings.Builder
+		g.WriteDOT(&b)
+		if i == 0 {
+			want = b.String()
+		} else if b.String() != want {
+			t.Fatalf("run %d =\n%s\nwant\n%s", i, b.String(), want)
+		}
+	}
+	if !strings.Contains(want, `"3" -> "4";`) {
+		t.Errorf("graph lacks the edge to a node discovered earlier:\n%s", want)
+	}
+}
+
+func TestCompaniesContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mock := companies()
+	g, err := Companies(mock, []string{"1"}, &Options{Context: ctx})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := nodeIDs(g); !reflect.DeepEqual(got, []string{"1"}) {
+		t.Errorf("nodes = %v, want only the seed", got)
+	}
+	if n := len(mock.GetSimilarCompaniesCalls()); n != 0 {
+		t.Errorf("expanded %d nodes after cancellation", n)
+	}
+}
+
+func TestCompaniesResolvesSeeds(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+	srv.Fixture("api/v1/companies/company/universal-name-to-id", map[string]string{"universalName": "google"}, map[string]any{"id": 1441})
+	srv.Fixture("api/v1/companies/company/similar", map[string]string{"id": "1441"}, map[string]any{
+		"companies": []any{map[string]any{"id": 1035, "name": "Microsoft"}},
+	})
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	g, err := Companies(client, []string{"google", "1441"}, &Options{MaxDepth: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := nodeIDs(g); !reflect.DeepEqual(got, []string{"1441", "1035"}) {
+		t.Errorf("nodes = %v, want the resolved seed once and its neighbor", got)
+	}
+	if id, err := client.Resolver().CompanyID("google"); err != nil || id != "1441" {
+		t.Errorf("Resolver().CompanyID(google) = %s, %v; want the crawl's lookup cached", id, err)
+	}
+	if n := len(srv.RequestsTo("api/v1/companies/company/universal-name-to-id")); n != 1 {
+		t.Errorf("name lookups = %d, want 1", n)
+	}
+
+	// A canceled crawl does not look up its seeds
+	srv.Reset()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	g, err = Companies(client, []string{"microsoft"}, &Options{Context: ctx})
+	if !errors.Is(err, context.Canceled) || len(g.Nodes) != 0 {
+		t.Errorf("canceled crawl = %v nodes, err %v; want none and context.Canceled", len(g.Nodes), err)
+	}
+	if reqs := srv.Requests(); len(reqs) != 0 {
+		t.Errorf("canceled crawl sent %d requests", len(reqs))
+	}
+}
+
+func TestProfilesWithoutResolver(t *testing.T) {
+	_, err := Profiles(&linkdapimock.Client{}, []string{"ryanroslansky"}, nil)
+	if !errors.Is(err, linkdapi.ErrInvalidIdentifier) {
+		t.Errorf("username seed without a Resolver: err = %v, want ErrInvalidIdentifier", err)
+	}
+}
+
+func TestWriteEscaping(t *testing.T) {
+	g := newGraph()
+	g.add(Node{ID: "1", Kind: KindCompany, Name: `Acme "Labs" <R&D>` + "\nInc"})
+	g.add(Node{ID: `2\"`, Kind: KindCompany})
+	g.link("1", `2\"`)
+
+	var dot strings.Builder
+	if err := g.WriteDOT(&dot); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"1" [label="Acme \"Labs\" <R&D>\nInc", kind="company", depth=0];`,
+		`"2\\\"" [label="2\\\"", kind="company", depth=0];`,
+		`"1" -> "2\\\"";`,
+	} {
+		if !strings.Contains(dot.String(), want) {
+			t.Errorf("WriteDOT lacks %s:\n%s", want, dot.String())
+		}
+	}
+
+	var ml strings.Builder
+	if err := g.WriteGraphML(&ml); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<data key="name">Acme &#34;Labs&#34; &lt;R&amp;D&gt;&#xA;Inc</data>`,
+		`<node id="2\&#34;">`,
+		`<edge id="e0" source="1" target="2\&#34;"/>`,
+	} {
+		if !strings.Contains(ml.String(), want) {
+			t.Errorf("WriteGraphML lacks %s:\n%s", want, ml.String())
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package crawl
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Graph is the result of a crawl: nodes in the order they were discovered
+// and the similar relations between them.
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+
+	index map[string]int // Node ID -> position in Nodes
+	edges map[Edge]bool  // De-duplicates Edges
+}
+
+func newGraph() *Graph {
+	return &Graph{index: make(map[string]int), edges: make(map[Edge]bool)}
+}
+
+// add adds n unless a node with its ID exists, reporting whether it did.
+func (g *Graph) add(n Node) bool {
+	if _, ok := g.index[n.ID]; ok {
+		return false
+	}
+	g.index[n.ID] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, n)
+	return true
+}
+
+// link adds an edge between two nodes in the graph, once.
+func (g *Graph) link(from, to string) {
+	e := Edge{From: from, To: to}
+	if !g.edges[e] {
+		g.edges[e] = true
+		g.Edges = append(g.Edges, e)
+	}
+}
+
+// Node returns the node with id, if it is in the graph.
+func (g *Graph) Node(id string) (Node, bool) {
+	i, ok := g.index[id]
+	if !ok {
+		return Node{}, false
+	}
+	return g.Nodes[i], true
+}
+
+// Adjacency returns the IDs each node links to, in discovery order. Every
+// node has an entry, possibly empty.
+func (g *Graph) Adjacency() map[string][]string {
+	adj := make(map[string][]string, len(g.Nodes))
+	for _, n := range g.Nodes {
+		adj[n.ID] = []string{}
+	}
+	for _, e := range g.Edges {
+		adj[e.From] = append(adj[e.From], e.To)
+	}
+	return adj
+}
+
+// WriteDOT writes the graph in Graphviz DOT format, labelling nodes with
+// their names.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph similar {")
+	for _, n := range g.Nodes {
+		label := n.Name
+		if label == "" {
+			label = n.ID
+		}
+		fmt.Fprintf(bw, "  %s [label=%s, kind=%s, depth=%d];\n", dotID(n.ID), dotID(label), dotID(string(n.Kind)), n.Depth)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(bw, "  %s -> %s;\n", dotID(e.From), dotID(e.To))
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// WriteGraphML writes the graph in GraphML format, with each node's kind,
+// name and depth as data attributes.
+func (g *Graph) WriteGraphML(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, `<?xml version="1.0" encoding="UTF-8"?>`)
+	fmt.Fprintln(bw, `<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`)
+	fmt.Fprintln(bw, `  <key id="kind" for="node" attr.name="kind" attr.type="string"/>`)
+	fmt.Fprintln(bw, `  <key id="name" for="node" attr.name="name" attr.type="string"/>`)
+	fmt.Fprintln(bw, `  <key id="depth" for="node" attr.name="depth" attr.type="int"/>`)
+	fmt.Fprintln(bw, `  <graph id="similar" edgedefault="directed">`)
+	for _, n := range g.Nodes {
+		fmt.Fprintf(bw, "    <node id=\"%s\">\n", xmlEscape(n.ID))
+		fmt.Fprintf(bw, "      <data key=\"kind\">%s</data>\n", xmlEscape(string(n.Kind)))
+		if n.Name != "" {
+			fmt.Fprintf(bw, "      <data key=\"name\">%s</data>\n", xmlEscape(n.Name))
+		}
+		fmt.Fprintf(bw, "      <data key=\"depth\">%d</data>\n", n.Depth)
+		fmt.Fprintln(bw, "    </node>")
+	}
+	for i, e := range g.Edges {
+		fmt.Fprintf(bw, "    <edge id=\"e%d\" source=\"%s\" target=\"%s\"/>\n", i, xmlEscape(e.From), xmlEscape(e.To))
+	}
+	fmt.Fprintln(bw, "  </graph>")
+	fmt.Fprintln(bw, "</graphml>")
+	return bw.Flush()
+}
+
+// dotID quotes s as a DOT identifier.
+func dotID(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+// xmlEscape escapes s for use in XML text and attribute values.
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// String summarizes the graph, e.g. "12 nodes, 30 edges".
+func (g *Graph) String() string {
+	return strconv.Itoa(len(g.Nodes)) + " nodes, " + strconv.Itoa(len(g.Edges)) + " edges"
+}
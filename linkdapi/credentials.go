@@ -0,0 +1,131 @@
+package linkdapi
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EnvAPIKey is the environment variable read by EnvCredentials by default.
+const EnvAPIKey = "LINKDAPI_API_KEY"
+
+// CredentialsProvider supplies the API key. The client asks for it before
+// every request, so a provider can pick up rotated secrets without a
+// restart. Set Config.Credentials to use one.
+//
+// Implementations must be safe for concurrent use.
+type CredentialsProvider interface {
+	// APIKey returns the key to use for the next request.
+	APIKey() (string, error)
+}
+
+// credentialsKeys adapts a CredentialsProvider to a KeyProvider that
+// ignores request outcomes.
+type credentialsKeys struct {
+	CredentialsProvider
+}
+
+func (credentialsKeys) Report(string, int, string) bool { return false }
+
+// EnvCredentials reads the API key from an environment variable on every
+// request.
+//
+// Example:
+//
+//	config := linkdapi.DefaultConfig()
+//	config.Credentials = linkdapi.EnvCredentials{}
+//	client := linkdapi.NewClientWithConfig("", config)
+type EnvCredentials struct {
+	// Var is the environment variable holding the key (default: "LINKDAPI_API_KEY")
+	Var string
+}
+
+// APIKey returns the value of the environment variable.
+func (e EnvCredentials) APIKey() (string, error) {
+	name := e.Var
+	if name == "" {
+		name = EnvAPIKey
+	}
+	key := strings.TrimSpace(os.Getenv(name))
+	if key == "" {
+		return "", fmt.Errorf("%w: environment variable %s is not set", ErrNoKeys, name)
+	}
+	return key, nil
+}
+
+// FileCredentials reads the API key from a file, such as a mounted secret,
+// and reloads it when the file changes. Changes are noticed on the first
+// request after the check interval has passed.
+//
+// If the file briefly disappears or becomes empty while a secret is being
+// rotated, the last key read is kept.
+//
+// The FileCredentials is safe for concurrent use by multiple goroutines.
+type FileCredentials struct {
+	path     string
+	interval time.Duration
+
+	mu      sync.Mutex
+	key     string
+	modTime time.Time
+	size    int64
+	checked time.Time
+}
+
+// NewFileCredentials creates a provider reading the key from path, checking
+// for changes at most once per interval (default: 10 seconds). The file is
+// read immediately so a missing file is reported early.
+func NewFileCredentials(path string, interval time.Duration) (*FileCredentials, error) {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	f := &FileCredentials{path: path, interval: interval}
+	if _, err := f.APIKey(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// APIKey returns the key in the file, reloading it if the file changed.
+func (f *FileCredentials) APIKey() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	if f.key != "" && now.Sub(f.checked) < f.interval {
+		return f.key, nil
+	}
+	f.checked = now
+
+	if err := f.reload(); err != nil {
+		if f.key != "" {
+			return f.key, nil
+		}
+		return "", err
+	}
+	return f.key, nil
+}
+
+// reload reads the file if its size or modification time changed.
+func (f *FileCredentials) reload() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return fmt.Errorf("failed to read credentials file: %w", err)
+	}
+	if f.key != "" && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return nil
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return fmt.Errorf("failed to read credentials file: %w", err)
+	}
+	key := strings.TrimSpace(string(data))
+	if key == "" {
+		return fmt.Errorf("%w: credentials file %s is empty", ErrNoKeys, f.path)
+	}
+	f.key, f.modTime, f.size = key, info.ModTime(), info.Size()
+	return nil
+}
@@ -0,0 +1,841 @@
+package linkdapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapitest"
+)
+
+// testURN is a profile URN in the form returned by GetProfileURN.
+const testURN = "ACoAAAEkwwAB9KEc2TrQgOLEQ-vzRyZeCDyc6DQ"
+
+type endpointCase struct {
+	name      string
+	call      func(c *linkdapi.Client) (map[string]any, error)
+	endpoint  string
+	wantQuery map[string]string
+}
+
+var endpointCases = []endpointCase{
+	// Profile
+	{"GetProfileOverview", func(c *linkdapi.Client) (map[string]any, error) { return c.GetProfileOverview("ryanroslansky") },
+		"api/v1/profile/overview", map[string]string{"username": "ryanroslansky"}},
+	{"GetProfileDetails", func(c *linkdapi.Client) (map[string]any, error) { return c.GetProfileDetails(testURN) },
+		"api/v1/profile/details", map[string]string{"urn": testURN}},
+	{"GetContactInfo", func(c *linkdapi.Client) (map[string]any, error) { return c.GetContactInfo("ryanroslansky") },
+		"api/v1/profile/contact-info", map[string]string{"username": "ryanroslansky"}},
+	{"GetFullExperience", func(c *linkdapi.Client) (map[string]any, error) { return c.GetFullExperience(testURN) },
+		"api/v1/profile/full-experience", map[string]string{"urn": testURN}},
+	{"GetCertifications", func(c *linkdapi.Client) (map[string]any, error) { return c.GetCertifications(testURN) },
+		"api/v1/profile/certifications", map[string]string{"urn": testURN}},
+	{"GetEducation", func(c *linkdapi.Client) (map[string]any, error) { return c.GetEducation(testURN) },
+		"api/v1/profile/education", map[string]string{"urn": testURN}},
+	{"GetSkills", func(c *linkdapi.Client) (map[string]any, error) { return c.GetSkills(testURN) },
+		"api/v1/profile/skills", map[string]string{"urn": testURN}},
+	{"GetSocialMatrix", func(c *linkdapi.Client) (map[string]any, error) { return c.GetSocialMatrix("ryanroslansky") },
+		"api/v1/profile/social-matrix", map[string]string{"username": "ryanroslansky"}},
+	{"GetRecommendations", func(c *linkdapi.Client) (map[string]any, error) { return c.GetRecommendations(testURN) },
+		"api/v1/profile/recommendations", map[string]string{"urn": testURN}},
+	{"GetSimilarProfiles", func(c *linkdapi.Client) (map[string]any, error) { return c.GetSimilarProfiles(testURN) },
+		"api/v1/profile/similar", map[string]string{"urn": testURN}},
+	{"GetProfileAbout", func(c *linkdapi.Client) (map[string]any, error) { return c.GetProfileAbout(testURN) },
+		"api/v1/profile/about", map[string]string{"urn": testURN}},
+	{"GetProfileReactions", func(c *linkdapi.Client) (map[string]any, error) { return c.GetProfileReactions(testURN, "next") },
+		"api/v1/profile/reactions", map[string]string{"urn": testURN, "cursor": "next"}},
+	{"GetProfileReactions no cursor", func(c *linkdapi.Client) (map[string]any, error) { return c.GetProfileReactions(testURN, "") },
+		"api/v1/profile/reactions", map[string]string{"urn": testURN}},
+	{"GetProfileInterests", func(c *linkdapi.Client) (map[string]any, error) { return c.GetProfileInterests(testURN) },
+		"api/v1/profile/interests", map[string]string{"urn": testURN}},
+	{"GetFullProfile by username", func(c *linkdapi.Client) (map[string]any, error) { return c.GetFullProfile("ryanroslansky", "") },
+		"api/v1/profile/full", map[string]string{"username": "ryanroslansky"}},
+	{"GetFullProfile by urn", func(c *linkdapi.Client) (map[string]any, error) { return c.GetFullProfile("", testURN) },
+		"api/v1/profile/full", map[string]string{"urn": testURN}},
+	{"GetProfileServices", func(c *linkdapi.Client) (map[string]any, error) { return c.GetProfileServices(testURN) },
+		"api/v1/profile/services", map[string]string{"urn": testURN}},
+	{"GetProfileURN", func(c *linkdapi.Client) (map[string]any, error) { return c.GetProfileURN("ryanroslansky") },
+		"api/v1/profile/username-to-urn", map[string]string{"username": "ryanroslansky"}},
+	{"GetProfilePostedJobs", func(c *linkdapi.Client) (map[string]any, error) { return c.GetProfilePostedJobs(testURN, 10, 5) },
+		"api/v1/jobs/posted-by-profile", map[string]string{"profileUrn": testURN, "start": "10", "count": "5"}},
+
+	// Companies
+	{"CompanyNameLookup", func(c *linkdapi.Client) (map[string]any, error) { return c.CompanyNameLookup("goo") },
+		"api/v1/companies/name-lookup", map[string]string{"query": "goo"}},
+	{"GetCompanyInfo by id", func(c *linkdapi.Client) (map[string]any, error) { return c.GetCompanyInfo("1441", "") },
+		"api/v1/companies/company/info", map[string]string{"id": "1441"}},
+	{"GetCompanyInfo by name", func(c *linkdapi.Client) (map[string]any, error) { return c.GetCompanyInfo("", "google") },
+		"api/v1/companies/company/info", map[string]string{"name": "google"}},
+	{"GetSimilarCompanies", func(c *linkdapi.Client) (map[string]any, error) { return c.GetSimilarCompanies("1441") },
+		"api/v1/companies/company/similar", map[string]string{"id": "1441"}},
+	{"GetCompanyEmployeesData", func(c *linkdapi.Client) (map[string]any, error) { return c.GetCompanyEmployeesData("1441") },
+		"api/v1/companies/company/employees-data", map[string]string{"id": "1441"}},
+	{"GetCompanyJobs", func(c *linkdapi.Client) (map[string]any, error) {
+		return c.GetCompanyJobs([]string{"1441", "1035"}, 25)
+	}, "api/v1/companies/jobs", map[string]string{"companyIDs": "1441,1035", "start": "25"}},
+	{"GetCompanyAffiliatedPages", func(c *linkdapi.Client) (map[string]any, error) { return c.GetCompanyAffiliatedPages("1441") },
+		"api/v1/companies/company/affiliated-pages", map[string]string{"id": "1441"}},
+	{"GetCompanyPosts", func(c *linkdapi.Client) (map[string]any, error) { return c.GetCompanyPosts("1441", 10) },
+		"api/v1/companies/company/posts", map[string]string{"id": "1441", "start": "10"}},
+	{"GetCompanyID", func(c *linkdapi.Client) (map[string]any, error) { return c.GetCompanyID("google") },
+		"api/v1/companies/company/universal-name-to-id", map[string]string{"universalName": "google"}},
+	{"GetCompanyDetailsV2", func(c *linkdapi.Client) (map[string]any, error) { return c.GetCompanyDetailsV2("1441") },
+		"api/v1/companies/company/info-v2", map[string]string{"id": "1441"}},
+
+	// Jobs
+	{"SearchJobs", func(c *linkdapi.Client) (map[string]any, error) {
+		return c.SearchJobs(linkdapi.JobSearchParams{Keyword: "golang", Start: linkdapi.Int(25)})
+	}, "api/v1/jobs/search", map[string]string{"keyword": "golang", "start": "25"}},
+	{"SearchJobsV2", func(c *linkdapi.Client) (map[string]any, error) {
+		return c.SearchJobsV2(linkdapi.JobSearchV2Params{Keyword: "golang", Start: linkdapi.Int(25), Count: linkdapi.Int(10)})
+	}, "api/v1/search/jobs", map[string]string{"keyword": "golang", "start": "25", "count": "10"}},
+	{"GetJobDetails", func(c *linkdapi.Client) (map[string]any, error) { return c.GetJobDetails("4012345678") },
+		"api/v1/jobs/job/details", map[string]string{"jobId": "4012345678"}},
+	{"GetSimilarJobs", func(c *linkdapi.Client) (map[string]any, error) { return c.GetSimilarJobs("4012345678") },
+		"api/v1/jobs/job/similar", map[string]string{"jobId": "4012345678"}},
+	{"GetPeopleAlsoViewedJobs", func(c *linkdapi.Client) (map[string]any, error) { return c.GetPeopleAlsoViewedJobs("4012345678") },
+		"api/v1/jobs/job/people-also-viewed", map[string]string{"jobId": "4012345678"}},
+	{"GetJobDetailsV2", func(c *linkdapi.Client) (map[string]any, error) { return c.GetJobDetailsV2("4012345678") },
+		"api/v1/jobs/job/details-v2", map[string]string{"jobId": "4012345678"}},
+	{"GetHiringTeam", func(c *linkdapi.Client) (map[string]any, error) { return c.GetHiringTeam("4012345678", 10) },
+		"api/v1/jobs/job/hiring-team", map[string]string{"jobId": "4012345678", "start": "10"}},
+
+	// Posts
+	{"GetFeaturedPosts", func(c *linkdapi.Client) (map[string]any, error) { return c.GetFeaturedPosts(testURN) },
+		"api/v1/posts/featured", map[string]string{"urn": testURN}},
+	{"GetAllPosts", func(c *linkdapi.Client) (map[string]any, error) { return c.GetAllPosts(testURN, "next", 20) },
+		"api/v1/posts/all", map[string]string{"urn": testURN, "cursor": "next", "start": "20"}},
+	{"GetPostInfo", func(c *linkdapi.Client) (map[string]any, error) { return c.GetPostInfo("7216040005151268864") },
+		"api/v1/posts/info", map[string]string{"urn": "7216040005151268864"}},
+	{"GetPostComments", func(c *linkdapi.Client) (map[string]any, error) {
+		return c.GetPostComments("7216040005151268864", 10, 20, "next")
+	}, "api/v1/posts/comments", map[string]string{"urn": "7216040005151268864", "start": "10", "count": "20", "cursor": "next"}},
+	{"GetPostLikes", func(c *linkdapi.Client) (map[string]any, error) { return c.GetPostLikes("7216040005151268864", 10) },
+		"api/v1/posts/likes", map[string]string{"urn": "7216040005151268864", "start": "10"}},
+
+	// Comments
+	{"GetAllComments", func(c *linkdapi.Client) (map[string]any, error) { return c.GetAllComments(testURN, "next") },
+		"api/v1/comments/all", map[string]string{"urn": testURN, "cursor": "next"}},
+	{"GetCommentLikes", func(c *linkdapi.Client) (map[string]any, error) { return c.GetCommentLikes("111,222", 10) },
+		"api/v1/comments/likes", map[string]string{"urn": "111,222", "start": "10"}},
+}
+
+func TestEndpoints(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	for _, tt := range endpointCases {
+		t.Run(tt.name, func(t *testing.T) {
+			srv.Reset()
+			srv.Fixture(tt.endpoint, nil, map[string]any{"endpoint": tt.endpoint})
+
+			resp, err := tt.call(client)
+			if err != nil {
+				t.Fatalf("call: %v", err)
+			}
+			if data, _ := resp["data"].(map[string]any); data["endpoint"] != tt.endpoint {
+				t.Errorf("response data = %v, want fixture for %s", resp["data"], tt.endpoint)
+			}
+
+			reqs := srv.Requests()
+			if len(reqs) != 1 {
+				t.Fatalf("got %d requests, want 1", len(reqs))
+			}
+			req := reqs[0]
+			if req.Method != "GET" {
+				t.Errorf("method = %s, want GET", req.Method)
+			}
+			if req.Endpoint != tt.endpoint {
+				t.Errorf("endpoint = %s, want %s", req.Endpoint, tt.endpoint)
+			}
+			assertQuery(t, req.Query, tt.wantQuery)
+		})
+	}
+}
+
+func TestEndpointsCoverServer(t *testing.T) {
+	covered := make(map[string]bool)
+	for _, tt := range endpointCases {
+		covered[tt.endpoint] = true
+	}
+	for _, endpoint := range linkdapitest.Endpoints {
+		if !covered[endpoint] {
+			t.Errorf("endpoint %s has no test case", endpoint)
+		}
+	}
+}
+
+func TestEndpointArgumentValidation(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	if _, err := client.GetFullProfile("", ""); err == nil {
+		t.Error("GetFullProfile with no username or urn: expected error")
+	}
+	if _, err := client.GetCompanyInfo("", ""); err == nil {
+		t.Error("GetCompanyInfo with no id or name: expected error")
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Errorf("invalid calls sent %d requests, want 0", n)
+	}
+}
+
+func TestEndpointInvalidIdentifiers(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	calls := map[string]func() (map[string]any, error){
+		"username with space":     func() (map[string]any, error) { return client.GetSkills("ryan roslansky") },
+		"unknown URN as username": func() (map[string]any, error) { return client.GetProfileOverview(testURN) },
+		"empty username":          func() (map[string]any, error) { return client.GetContactInfo("") },
+		"username with slash":     func() (map[string]any, error) { return client.GetSocialMatrix("in/ryanroslansky") },
+		"full profile bad urn":    func() (map[string]any, error) { return client.GetFullProfile("", "ryanroslansky") },
+		"empty company ID":        func() (map[string]any, error) { return client.GetSimilarCompanies(" ") },
+		"bad ID in company IDs":   func() (map[string]any, error) { return client.GetCompanyJobs([]string{"1441", ""}, 0) },
+		"bad job ID":              func() (map[string]any, error) { return client.GetJobDetails("job-1") },
+		"profile URN as post":     func() (map[string]any, error) { return client.GetPostInfo(testURN) },
+		"bad comment URN":         func() (map[string]any, error) { return client.GetCommentLikes("111,abc", 0) },
+		"post URN as profile": func() (map[string]any, error) {
+			return client.GetAllPosts("urn:li:activity:7216040005151268864", "", 0)
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if _, err := call(); !errors.Is(err, linkdapi.ErrInvalidIdentifier) {
+				t.Errorf("err = %v, want ErrInvalidIdentifier", err)
+			}
+		})
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Errorf("invalid identifiers sent %d requests, want 0", n)
+	}
+}
+
+func TestEndpointNormalizesIdentifiers(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	tests := []struct {
+		name      string
+		call      func() (map[string]any, error)
+		wantQuery map[string]string
+	}{
+		{"prefixed profile URN", func() (map[string]any, error) { return client.GetSkills("urn:li:fsd_profile:" + testURN) },
+			map[string]string{"urn": testURN}},
+		{"username with @", func() (map[string]any, error) { return client.GetProfileOverview("@ryanroslansky") },
+			map[string]string{"username": "ryanroslansky"}},
+		{"activity URN", func() (map[string]any, error) { return client.GetPostInfo("urn:li:activity:7216040005151268864") },
+			map[string]string{"urn": "7216040005151268864"}},
+		{"comment URNs", func() (map[string]any, error) {
+			return client.GetCommentLikes("urn:li:comment:(activity:7216040005151268864,7216041234567890123),222", 10)
+		}, map[string]string{"urn": "7216041234567890123,222", "start": "10"}},
+		{"company URN", func() (map[string]any, error) { return client.GetCompanyDetailsV2("urn:li:organization:1441") },
+			map[string]string{"id": "1441"}},
+		{"job posting URN", func() (map[string]any, error) { return client.GetJobDetailsV2("urn:li:jobPosting:4012345678") },
+			map[string]string{"jobId": "4012345678"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv.Reset()
+			if _, err := tt.call(); err != nil {
+				t.Fatalf("call: %v", err)
+			}
+			reqs := srv.Requests()
+			if len(reqs) != 1 {
+				t.Fatalf("got %d requests, want 1", len(reqs))
+			}
+			assertQuery(t, reqs[0].Query, tt.wantQuery)
+		})
+	}
+}
+
+func TestEndpointResolvesIdentifiers(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	srv.Fixture("api/v1/profile/username-to-urn", map[string]string{"username": "ryanroslansky"}, map[string]any{"urn": testURN})
+	srv.Fixture("api/v1/companies/company/universal-name-to-id", map[string]string{"universalName": "google"}, map[string]any{"id": 1441})
+	srv.Respond("api/v1/companies/company/universal-name-to-id", map[string]string{"universalName": "Acme Corp"}, linkdapitest.Unsuccessful("Company not found"))
+	srv.Fixture("api/v1/companies/name-lookup", map[string]string{"query": "Acme Corp"}, []any{map[string]any{"id": "2002", "name": "Acme"}})
+
+	cacheFile := filepath.Join(t.TempDir(), "resolver.json")
+	client := srv.NewClient(&linkdapi.Config{ResolverCacheFile: cacheFile})
+	defer client.Close()
+
+	// Usernames are resolved once, then served from the cache
+	for range 2 {
+		if _, err := client.GetSkills("ryanroslansky"); err != nil {
+			t.Fatalf("GetSkills: %v", err)
+		}
+	}
+	if n := len(srv.RequestsTo("api/v1/profile/username-to-urn")); n != 1 {
+		t.Errorf("username-to-urn requests = %d, want 1", n)
+	}
+	skills := srv.RequestsTo("api/v1/profile/skills")
+	if len(skills) != 2 || skills[1].Query.Get("urn") != testURN {
+		t.Errorf("skills requests = %v, want 2 with urn %s", skills, testURN)
+	}
+
+	// Known URNs map back to usernames for username-only endpoints
+	if _, err := client.GetProfileOverview(testURN); err != nil {
+		t.Fatalf("GetProfileOverview(urn): %v", err)
+	}
+	if reqs := srv.RequestsTo("api/v1/profile/overview"); len(reqs) != 1 || reqs[0].Query.Get("username") != "ryanroslansky" {
+		t.Errorf("overview requests = %v, want username ryanroslansky", reqs)
+	}
+
+	// Universal names resolve with GetCompanyID, other names with the lookup
+	if _, err := client.GetSimilarCompanies("google"); err != nil {
+		t.Fatalf("GetSimilarCompanies: %v", err)
+	}
+	if _, err := client.GetCompanyJobs([]string{"google", "Acme Corp", "1035"}, 0); err != nil {
+		t.Fatalf("GetCompanyJobs: %v", err)
+	}
+	if reqs := srv.RequestsTo("api/v1/companies/jobs"); len(reqs) != 1 || reqs[0].Query.Get("companyIDs") != "1441,2002,1035" {
+		t.Errorf("company jobs requests = %v, want companyIDs 1441,2002,1035", reqs)
+	}
+
+	// Mappings persist to the cache file and are loaded by new clients
+	srv.Reset()
+	srv.Fixture("api/v1/profile/username-to-urn", nil, map[string]any{"urn": "ACoAABBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB"})
+	other := srv.NewClient(&linkdapi.Config{ResolverCacheFile: cacheFile})
+	defer other.Close()
+
+	urns, err := other.Resolver().ResolveProfiles([]string{"ryanroslansky", "satyanadella", testURN}, 2)
+	if err != nil {
+		t.Fatalf("ResolveProfiles: %v", err)
+	}
+	if urns["ryanroslansky"] != testURN || urns[testURN] != testURN || urns["satyanadella"] == "" {
+		t.Errorf("ResolveProfiles = %v", urns)
+	}
+	if n := len(srv.RequestsTo("api/v1/profile/username-to-urn")); n != 1 {
+		t.Errorf("username-to-urn requests = %d, want 1 (cached usernames are not looked up)", n)
+	}
+	if id, err := other.Resolver().CompanyID("ACME CORP"); err != nil || id != "2002" {
+		t.Errorf("CompanyID(ACME CORP) = %q, %v; want 2002 from the cache file", id, err)
+	}
+}
+
+func TestResolverCompanyLookupErrors(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	// A failed request is returned without spending a name lookup
+	srv.FailNext("api/v1/companies/company/universal-name-to-id", 1, linkdapitest.Error(http.StatusBadRequest, "bad request"))
+	if _, err := client.Resolver().CompanyID("google"); err == nil {
+		t.Fatal("CompanyID succeeded after a failed request")
+	}
+	if n := len(srv.RequestsTo("api/v1/companies/name-lookup")); n != 0 {
+		t.Errorf("name-lookup requests = %d after a request error, want 0", n)
+	}
+
+	// An unsuccessful response falls back to the name lookup
+	srv.Respond("api/v1/companies/company/universal-name-to-id", nil, linkdapitest.Unsuccessful("Company not found"))
+	srv.Fixture("api/v1/companies/name-lookup", nil, []any{map[string]any{"id": "2002"}})
+	if id, err := client.Resolver().CompanyID("Acme Corp"); err != nil || id != "2002" {
+		t.Errorf("CompanyID(Acme Corp) = %q, %v; want 2002", id, err)
+	}
+}
+
+func TestResolverSavesOncePerBatch(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+	srv.SetLatency(5 * time.Millisecond)
+
+	dir := t.TempDir()
+	cacheFile := filepath.Join(dir, "resolver.json")
+	client := srv.NewClient(&linkdapi.Config{ResolverCacheFile: cacheFile})
+	defer client.Close()
+
+	names := make([]string, 20)
+	for i := range names {
+		names[i] = fmt.Sprintf("user%d", i)
+		srv.Fixture("api/v1/profile/username-to-urn", map[string]string{"username": names[i]}, map[string]any{"urn": fmt.Sprintf("ACoAAA%014d", i)})
+	}
+	urns, err := client.Resolver().ResolveProfiles(names, 8)
+	if err != nil || len(urns) != len(names) {
+		t.Fatalf("ResolveProfiles = %d URNs, %v; want %d", len(urns), err, len(names))
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "resolver.json" {
+		t.Errorf("cache dir holds %v, want only resolver.json", entries)
+	}
+
+	other := srv.NewClient(&linkdapi.Config{ResolverCacheFile: cacheFile})
+	defer other.Close()
+	srv.Reset()
+	for _, name := range names {
+		if _, err := other.Resolver().ProfileURN(name); err != nil {
+			t.Errorf("ProfileURN(%s) from the cache file: %v", name, err)
+		}
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Errorf("requests = %d, want 0 with every username cached", n)
+	}
+}
+
+func TestAssembleProfile(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	srv.Fixture("api/v1/profile/username-to-urn", map[string]string{"username": "ryanroslansky"}, map[string]any{"urn": testURN})
+	srv.Fixture("api/v1/profile/overview", nil, map[string]any{"fullName": "Ryan Roslansky"})
+	srv.Fixture("api/v1/profile/skills", nil, []any{"Leadership"})
+	srv.Respond("api/v1/profile/education", nil, linkdapitest.Unsuccessful("Education not available"))
+	srv.FailNext("api/v1/profile/contact-info", 10, linkdapitest.Error(http.StatusInternalServerError, "boom"))
+
+	client := srv.NewClient(&linkdapi.Config{MaxRetries: 0})
+	defer client.Close()
+
+	profile, err := client.AssembleProfile("ryanroslansky", nil, linkdapi.WithHeader("X-Trace", "1"))
+	if err != nil {
+		t.Fatalf("AssembleProfile: %v", err)
+	}
+	if profile.Username != "ryanroslansky" || profile.URN != testURN {
+		t.Errorf("identity = %s, %s", profile.Username, profile.URN)
+	}
+	if profile.Overview["fullName"] != "Ryan Roslansky" {
+		t.Errorf("Overview = %v", profile.Overview)
+	}
+	if len(profile.Skills) != 1 || profile.Skills[0]["name"] != "Leadership" {
+		t.Errorf("Skills = %v, want [{name: Leadership}]", profile.Skills)
+	}
+	if profile.Experience == nil || len(profile.Experience) != 0 {
+		t.Errorf("Experience = %#v, want no items for an object without a list", profile.Experience)
+	}
+	if profile.Details == nil || profile.Education != nil || profile.ContactInfo != nil {
+		t.Errorf("Details = %v, Education = %v, ContactInfo = %v", profile.Details, profile.Education, profile.ContactInfo)
+	}
+	if len(profile.Failed) != 2 || !errors.Is(profile.Failed[linkdapi.SectionEducation], linkdapi.ErrUnsuccessful) ||
+		profile.Failed[linkdapi.SectionContactInfo] == nil {
+		t.Errorf("Failed = %v, want education and contact_info", profile.Failed)
+	}
+	if err := profile.Err(); err == nil || !strings.HasPrefix(err.Error(), "contact_info: ") {
+		t.Errorf("Err = %v", err)
+	}
+
+	// Every section is fetched once with the resolved URN and the options
+	if n := len(srv.RequestsTo("api/v1/profile/username-to-urn")); n != 1 {
+		t.Errorf("username-to-urn requests = %d, want 1", n)
+	}
+	for _, endpoint := range []string{"api/v1/profile/details", "api/v1/profile/full-experience", "api/v1/profile/interests"} {
+		reqs := srv.RequestsTo(endpoint)
+		if len(reqs) != 1 || reqs[0].Query.Get("urn") != testURN || reqs[0].Header.Get("X-Trace") != "1" {
+			t.Errorf("%s requests = %v", endpoint, reqs)
+		}
+	}
+
+	// A URN the client has not seen cannot be used for username sections
+	srv.Reset()
+	other := srv.NewClient(nil)
+	defer other.Close()
+	profile, err = other.AssembleProfile(testURN, []linkdapi.ProfileSection{linkdapi.SectionOverview, linkdapi.SectionSkills, linkdapi.SectionSkills})
+	if err != nil {
+		t.Fatalf("AssembleProfile(urn): %v", err)
+	}
+	if !errors.Is(profile.Failed[linkdapi.SectionOverview], linkdapi.ErrInvalidIdentifier) || profile.Skills == nil || profile.Username != "" {
+		t.Errorf("AssembleProfile(urn) = %+v", profile)
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("got %d requests, want 1 (duplicate sections are fetched once)", n)
+	}
+
+	if _, err := client.AssembleProfile("not a username", nil); !errors.Is(err, linkdapi.ErrInvalidIdentifier) {
+		t.Errorf("invalid identifier err = %v, want ErrInvalidIdentifier", err)
+	}
+	if _, err := client.AssembleProfile("ryanroslansky", []linkdapi.ProfileSection{"posts"}); !errors.Is(err, linkdapi.ErrInvalidParams) {
+		t.Errorf("unknown section err = %v, want ErrInvalidParams", err)
+	}
+}
+
+func TestAssembleProfileShapes(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	srv.Fixture("api/v1/profile/full-experience", nil, map[string]any{
+		"total":      2,
+		"experience": []any{map[string]any{"title": "CEO"}, map[string]any{"title": "SVP"}},
+	})
+	srv.Fixture("api/v1/profile/education", nil, map[string]any{"schools": []any{map[string]any{"name": "Stanford"}}})
+	srv.Fixture("api/v1/profile/certifications", nil, []any{map[string]any{"name": "PMP"}})
+	srv.Fixture("api/v1/profile/skills", nil, "Leadership")
+	srv.Fixture("api/v1/profile/details", nil, []any{})
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	profile, err := client.AssembleProfile(testURN, []linkdapi.ProfileSection{
+		linkdapi.SectionExperience, linkdapi.SectionEducation, linkdapi.SectionCertifications,
+		linkdapi.SectionSkills, linkdapi.SectionDetails,
+	})
+	if err != nil {
+		t.Fatalf("AssembleProfile: %v", err)
+	}
+	if len(profile.Experience) != 2 || profile.Experience[1]["title"] != "SVP" {
+		t.Errorf("Experience = %v, want the list under \"experience\"", profile.Experience)
+	}
+	if len(profile.Education) != 1 || profile.Education[0]["name"] != "Stanford" {
+		t.Errorf("Education = %v, want the object's only list", profile.Education)
+	}
+	if len(profile.Certifications) != 1 || profile.Certifications[0]["name"] != "PMP" {
+		t.Errorf("Certifications = %v", profile.Certifications)
+	}
+
+	// Responses of another shape fail their section
+	if profile.Skills != nil || profile.Failed[linkdapi.SectionSkills] == nil {
+		t.Errorf("Skills = %v, Failed = %v; want a string to fail the section", profile.Skills, profile.Failed[linkdapi.SectionSkills])
+	}
+	if profile.Details != nil || profile.Failed[linkdapi.SectionDetails] == nil {
+		t.Errorf("Details = %v, Failed = %v; want a list to fail the section", profile.Details, profile.Failed[linkdapi.SectionDetails])
+	}
+}
+
+func TestAssembleProfileContext(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+	srv.SetLatency(time.Second)
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	profile, err := client.AssembleProfile(testURN, []linkdapi.ProfileSection{linkdapi.SectionDetails, linkdapi.SectionSkills}, linkdapi.WithContext(ctx))
+	if err != nil {
+		t.Fatalf("AssembleProfile: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("AssembleProfile took %v after its context ended", elapsed)
+	}
+	for _, s := range []linkdapi.ProfileSection{linkdapi.SectionDetails, linkdapi.SectionSkills} {
+		if err := profile.Failed[s]; !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Failed[%s] = %v, want context.DeadlineExceeded", s, err)
+		}
+	}
+}
+
+func TestSectionsShareTimeout(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+	srv.Fixture("api/v1/profile/username-to-urn", map[string]string{"username": "satyanadella"}, map[string]any{"urn": testURN})
+	srv.Fixture("api/v1/companies/company/universal-name-to-id", map[string]string{"universalName": "google"}, map[string]any{"id": 1441})
+	srv.SetLatency(60 * time.Millisecond)
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	// Each request fits in the timeout, the lookup and a section together do not
+	sections := []linkdapi.ProfileSection{linkdapi.SectionDetails, linkdapi.SectionSkills}
+	profile, err := client.AssembleProfile("satyanadella", sections, linkdapi.WithTimeout(100*time.Millisecond))
+	if err != nil {
+		t.Fatalf("AssembleProfile: %v", err)
+	}
+	for _, s := range sections {
+		if err := profile.Failed[s]; !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("profile Failed[%s] = %v, want context.DeadlineExceeded", s, err)
+		}
+	}
+
+	report, err := client.BuildCompanyReport("google", []linkdapi.CompanySection{linkdapi.CompanySectionInfo}, linkdapi.WithTimeout(100*time.Millisecond))
+	if err != nil {
+		t.Fatalf("BuildCompanyReport: %v", err)
+	}
+	if err := report.Failed[linkdapi.CompanySectionInfo]; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("report Failed[info] = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestBuildCompanyReport(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	srv.Fixture("api/v1/companies/company/universal-name-to-id", map[string]string{"universalName": "google"}, map[string]any{"id": 1441})
+	srv.Fixture("api/v1/companies/company/info", map[string]string{"id": "1441"}, map[string]any{"name": "Google"})
+	srv.Fixture("api/v1/companies/jobs", map[string]string{"companyIDs": "1441"}, map[string]any{"jobs": []any{map[string]any{"title": "SWE"}}, "total": 1})
+	srv.Fixture("api/v1/companies/company/posts", nil, "no posts")
+	srv.FailNext("api/v1/companies/company/similar", 10, linkdapitest.Error(http.StatusInternalServerError, "boom"))
+
+	client := srv.NewClient(&linkdapi.Config{MaxRetries: 0})
+	defer client.Close()
+
+	report, err := client.BuildCompanyReport("google", nil)
+	if err != nil {
+		t.Fatalf("BuildCompanyReport: %v", err)
+	}
+	if report.ID != "1441" || report.Info["name"] != "Google" {
+		t.Errorf("ID = %s, Info = %v", report.ID, report.Info)
+	}
+	if len(report.Jobs) != 1 || report.Jobs[0]["title"] != "SWE" {
+		t.Errorf("Jobs = %v, want the list under \"jobs\"", report.Jobs)
+	}
+	if report.Details == nil || report.Employees == nil || report.AffiliatedPages == nil {
+		t.Errorf("report missing sections: %+v", report)
+	}
+	if len(report.Failed) != 2 || report.Similar != nil || report.Failed[linkdapi.CompanySectionSimilar] == nil ||
+		report.Posts != nil || report.Failed[linkdapi.CompanySectionPosts] == nil {
+		t.Errorf("Failed = %v, want similar and posts (unexpected data)", report.Failed)
+	}
+	for _, endpoint := range []string{"api/v1/companies/company/info", "api/v1/companies/company/info-v2", "api/v1/companies/company/posts"} {
+		if reqs := srv.RequestsTo(endpoint); len(reqs) != 1 || reqs[0].Query.Get("id") != "1441" {
+			t.Errorf("%s requests = %v", endpoint, reqs)
+		}
+	}
+
+	// Canceling the context aborts the calls in flight
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report, err = client.BuildCompanyReport("1441", []linkdapi.CompanySection{linkdapi.CompanySectionInfo}, linkdapi.WithContext(ctx))
+	if err != nil || !errors.Is(report.Failed[linkdapi.CompanySectionInfo], context.Canceled) {
+		t.Errorf("BuildCompanyReport(canceled) = %+v, %v; want info to fail with context.Canceled", report, err)
+	}
+
+	// Only the chosen sections are fetched
+	srv.Reset()
+	report, err = client.BuildCompanyReport("1441", []linkdapi.CompanySection{linkdapi.CompanySectionPosts})
+	if err != nil || report.Err() != nil || report.Info != nil {
+		t.Errorf("BuildCompanyReport(posts) = %+v, %v", report, err)
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+
+	if _, err := client.BuildCompanyReport(" ", nil); !errors.Is(err, linkdapi.ErrInvalidIdentifier) {
+		t.Errorf("empty company err = %v, want ErrInvalidIdentifier", err)
+	}
+}
+
+func TestEndpointPaging(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	// Zero start and count are omitted so the API defaults apply
+	unset := []struct {
+		name      string
+		call      func() (map[string]any, error)
+		wantQuery map[string]string
+	}{
+		{"GetPostComments", func() (map[string]any, error) { return client.GetPostComments("7216040005151268864", 0, 0, "") },
+			map[string]string{"urn": "7216040005151268864"}},
+		{"GetProfilePostedJobs", func() (map[string]any, error) { return client.GetProfilePostedJobs(testURN, 0, 0) },
+			map[string]string{"profileUrn": testURN}},
+		{"GetCompanyPosts", func() (map[string]any, error) { return client.GetCompanyPosts("1441", 0) },
+			map[string]string{"id": "1441"}},
+		{"SearchJobsV2", func() (map[string]any, error) {
+			return client.SearchJobsV2(linkdapi.JobSearchV2Params{Keyword: "golang", Start: linkdapi.Int(0)})
+		}, map[string]string{"keyword": "golang", "start": "0"}},
+	}
+	for _, tt := range unset {
+		t.Run(tt.name, func(t *testing.T) {
+			srv.Reset()
+			if _, err := tt.call(); err != nil {
+				t.Fatalf("call: %v", err)
+			}
+			reqs := srv.Requests()
+			if len(reqs) != 1 {
+				t.Fatalf("got %d requests, want 1", len(reqs))
+			}
+			assertQuery(t, reqs[0].Query, tt.wantQuery)
+		})
+	}
+
+	// Out-of-range values fail before anything is sent, including lookups
+	srv.Reset()
+	invalid := map[string]func() (map[string]any, error){
+		"count above max":   func() (map[string]any, error) { return client.GetPostComments("7216040005151268864", 0, 51, "") },
+		"negative count":    func() (map[string]any, error) { return client.GetProfilePostedJobs(testURN, 0, -1) },
+		"negative start":    func() (map[string]any, error) { return client.GetPostLikes("7216040005151268864", -10) },
+		"before resolution": func() (map[string]any, error) { return client.GetAllPosts("ryanroslansky", "", -1) },
+		"search count": func() (map[string]any, error) {
+			return client.SearchJobsV2(linkdapi.JobSearchV2Params{Count: linkdapi.Int(100)})
+		},
+	}
+	for name, call := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, err := call(); !errors.Is(err, linkdapi.ErrInvalidParams) {
+				t.Errorf("err = %v, want ErrInvalidParams", err)
+			}
+		})
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Errorf("invalid paging sent %d requests, want 0", n)
+	}
+}
+
+func TestRequestOptions(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	t.Run("pagination overrides positional arguments", func(t *testing.T) {
+		srv.Reset()
+		_, err := client.GetPostComments("7216040005151268864", 10, 0, "old",
+			linkdapi.WithStart(20), linkdapi.WithCount(30), linkdapi.WithCursor("next"))
+		if err != nil {
+			t.Fatalf("GetPostComments: %v", err)
+		}
+		assertQuery(t, srv.Requests()[0].Query, map[string]string{
+			"urn": "7216040005151268864", "start": "20", "count": "30", "cursor": "next",
+		})
+	})
+
+	t.Run("pagination overrides params fields", func(t *testing.T) {
+		srv.Reset()
+		_, err := client.SearchJobsV2(linkdapi.JobSearchV2Params{Keyword: "golang", Count: linkdapi.Int(10)}, linkdapi.WithCount(50))
+		if err != nil {
+			t.Fatalf("SearchJobsV2: %v", err)
+		}
+		assertQuery(t, srv.Requests()[0].Query, map[string]string{"keyword": "golang", "count": "50"})
+	})
+
+	t.Run("unsupported options", func(t *testing.T) {
+		srv.Reset()
+		calls := map[string]func() (map[string]any, error){
+			"WithStart": func() (map[string]any, error) { return client.GetSkills(testURN, linkdapi.WithStart(10)) },
+			"WithCount": func() (map[string]any, error) {
+				return client.GetPostLikes("7216040005151268864", 0, linkdapi.WithCount(10))
+			},
+			"WithCursor": func() (map[string]any, error) {
+				return client.SearchJobs(linkdapi.JobSearchParams{}, linkdapi.WithCursor("x"))
+			},
+			"bounds": func() (map[string]any, error) {
+				return client.GetProfilePostedJobs(testURN, 0, 0, linkdapi.WithCount(80))
+			},
+		}
+		for name, call := range calls {
+			if _, err := call(); !errors.Is(err, linkdapi.ErrInvalidParams) {
+				t.Errorf("%s: err = %v, want ErrInvalidParams", name, err)
+			}
+		}
+		if n := len(srv.Requests()); n != 0 {
+			t.Errorf("invalid options sent %d requests, want 0", n)
+		}
+	})
+
+	t.Run("headers and no-cache", func(t *testing.T) {
+		srv.Reset()
+		srv.Fixture("api/v1/profile/username-to-urn", nil, map[string]any{"urn": testURN})
+		client.Resolver().Forget()
+		if _, err := client.GetSkills("ryanroslansky"); err != nil {
+			t.Fatalf("GetSkills: %v", err)
+		}
+		if _, err := client.GetSkills("ryanroslansky", linkdapi.WithNoCache(), linkdapi.WithHeader("X-Request-Id", "abc")); err != nil {
+			t.Fatalf("GetSkills: %v", err)
+		}
+
+		lookups := srv.RequestsTo("api/v1/profile/username-to-urn")
+		if len(lookups) != 2 {
+			t.Fatalf("username-to-urn requests = %d, want 2 (no-cache forces a new lookup)", len(lookups))
+		}
+		for _, req := range append(lookups[1:], srv.RequestsTo("api/v1/profile/skills")[1]) {
+			if got := req.Header.Get("Cache-Control"); got != "no-cache" {
+				t.Errorf("%s Cache-Control = %q, want no-cache", req.Endpoint, got)
+			}
+			if got := req.Header.Get("X-Request-Id"); got != "abc" {
+				t.Errorf("%s X-Request-Id = %q, want abc", req.Endpoint, got)
+			}
+		}
+		if got := srv.RequestsTo("api/v1/profile/skills")[0].Header.Get("X-Request-Id"); got != "" {
+			t.Errorf("header leaked into a call without the option: %q", got)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		srv.SetLatency(200 * time.Millisecond)
+		defer srv.SetLatency(0)
+
+		if _, err := client.GetSkills(testURN, linkdapi.WithTimeout(20*time.Millisecond)); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want context.DeadlineExceeded", err)
+		}
+	})
+
+	t.Run("timeout covers lookups", func(t *testing.T) {
+		srv.Fixture("api/v1/profile/username-to-urn", map[string]string{"username": "satyanadella"}, map[string]any{"urn": testURN})
+		srv.SetLatency(60 * time.Millisecond)
+		defer srv.SetLatency(0)
+
+		// Each request fits in the timeout, the lookup and the call together do not
+		if _, err := client.GetSkills("satyanadella", linkdapi.WithTimeout(100*time.Millisecond)); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want context.DeadlineExceeded", err)
+		}
+	})
+}
+
+func TestUnsuccessfulEnvelope(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	srv.Respond("api/v1/profile/overview", nil, linkdapitest.Unsuccessful("Profile not found"))
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	resp, err := client.GetProfileOverview("nobody")
+	if err != nil {
+		t.Fatalf("GetProfileOverview: %v", err)
+	}
+	if resp["success"] != false || resp["message"] != "Profile not found" {
+		t.Errorf("response = %v, want unsuccessful envelope", resp)
+	}
+}
+
+func TestInvalidAPIKey(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	client := linkdapi.NewClientWithConfig("wrong_key", &linkdapi.Config{BaseURL: srv.URL, MaxRetries: 3})
+	defer client.Close()
+
+	if _, err := client.GetProfileOverview("ryanroslansky"); err == nil {
+		t.Fatal("expected error for invalid API key")
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("got %d requests, want 1 (401 must not be retried)", n)
+	}
+}
+
+func assertQuery(t *testing.T, got url.Values, want map[string]string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Errorf("query = %v, want %v", got, want)
+	}
+	for key, value := range want {
+		if got.Get(key) != value {
+			t.Errorf("query[%q] = %q, want %q", key, got.Get(key), value)
+		}
+	}
+}
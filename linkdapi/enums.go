@@ -0,0 +1,156 @@
+package linkdapi
+
+// Allowed values for search parameters. A value outside these lists is
+// rejected by the params' Validate method before a request is sent.
+
+// JobType is an employment type filter.
+type JobType string
+
+const (
+	JobTypeFullTime   JobType = "full_time"
+	JobTypePartTime   JobType = "part_time"
+	JobTypeContract   JobType = "contract"
+	JobTypeTemporary  JobType = "temporary"
+	JobTypeInternship JobType = "internship"
+	JobTypeVolunteer  JobType = "volunteer"
+	JobTypeOther      JobType = "other" // SearchJobsV2 only
+)
+
+// ExperienceLevel is a seniority filter.
+type ExperienceLevel string
+
+const (
+	ExperienceInternship ExperienceLevel = "internship"
+	ExperienceEntryLevel ExperienceLevel = "entry_level"
+	ExperienceAssociate  ExperienceLevel = "associate"
+	ExperienceMidSenior  ExperienceLevel = "mid_senior"
+	ExperienceDirector   ExperienceLevel = "director"
+	ExperienceExecutive  ExperienceLevel = "executive" // SearchJobsV2 only
+)
+
+// TimePosted filters jobs by how recently they were posted.
+type TimePosted string
+
+const (
+	TimePostedAny   TimePosted = "any" // SearchJobs only
+	TimePosted24h   TimePosted = "24h"
+	TimePostedWeek  TimePosted = "1week"
+	TimePostedMonth TimePosted = "1month"
+)
+
+// Salary is a minimum annual salary filter.
+type Salary string
+
+const (
+	SalaryAny  Salary = "any" // SearchJobs only
+	Salary20k  Salary = "20k" // SearchJobsV2 only
+	Salary30k  Salary = "30k" // SearchJobsV2 only
+	Salary40k  Salary = "40k"
+	Salary50k  Salary = "50k" // SearchJobsV2 only
+	Salary60k  Salary = "60k"
+	Salary70k  Salary = "70k" // SearchJobsV2 only
+	Salary80k  Salary = "80k"
+	Salary90k  Salary = "90k" // SearchJobsV2 only
+	Salary100k Salary = "100k"
+	Salary120k Salary = "120k" // SearchJobs only
+)
+
+// WorkArrangement is an on-site, remote or hybrid filter.
+type WorkArrangement string
+
+const (
+	WorkOnsite WorkArrangement = "onsite"
+	WorkRemote WorkArrangement = "remote"
+	WorkHybrid WorkArrangement = "hybrid"
+)
+
+// Benefit is a job benefit filter.
+type Benefit string
+
+const (
+	BenefitMedicalInsurance    Benefit = "medical_ins"
+	BenefitDentalInsurance     Benefit = "dental_ins"
+	BenefitVisionInsurance     Benefit = "vision_ins"
+	Benefit401k                Benefit = "401k"
+	BenefitPension             Benefit = "pension"
+	BenefitPaidMaternity       Benefit = "paid_maternity"
+	BenefitPaidPaternity       Benefit = "paid_paternity"
+	BenefitCommuter            Benefit = "commuter"
+	BenefitStudentLoan         Benefit = "student_loan"
+	BenefitTuition             Benefit = "tuition"
+	BenefitDisabilityInsurance Benefit = "disability_ins"
+)
+
+// Commitment is a company values filter.
+type Commitment string
+
+const (
+	CommitmentDEI           Commitment = "dei"
+	CommitmentEnvironmental Commitment = "environmental"
+	CommitmentWorkLife      Commitment = "work_life"
+	CommitmentSocialImpact  Commitment = "social_impact"
+	CommitmentCareerGrowth  Commitment = "career_growth"
+)
+
+// SortBy is a result ordering.
+type SortBy string
+
+const (
+	SortByRelevance  SortBy = "relevance"
+	SortByDatePosted SortBy = "date_posted"
+)
+
+// PostContentType filters posts by content type.
+type PostContentType string
+
+const (
+	PostContentVideos                PostContentType = "videos"
+	PostContentPhotos                PostContentType = "photos"
+	PostContentJobs                  PostContentType = "jobs"
+	PostContentLiveVideos            PostContentType = "liveVideos"
+	PostContentDocuments             PostContentType = "documents"
+	PostContentCollaborativeArticles PostContentType = "collaborativeArticles"
+)
+
+// PostDatePosted filters posts by how recently they were posted.
+type PostDatePosted string
+
+const (
+	PostPast24h   PostDatePosted = "past-24h"
+	PostPastWeek  PostDatePosted = "past-week"
+	PostPastMonth PostDatePosted = "past-month"
+	PostPastYear  PostDatePosted = "past-year"
+)
+
+// CompanySize is a company headcount range filter.
+type CompanySize string
+
+const (
+	CompanySize1To10       CompanySize = "1-10"
+	CompanySize11To50      CompanySize = "11-50"
+	CompanySize51To200     CompanySize = "51-200"
+	CompanySize201To500    CompanySize = "201-500"
+	CompanySize501To1000   CompanySize = "501-1000"
+	CompanySize1001To5000  CompanySize = "1001-5000"
+	CompanySize5001To10000 CompanySize = "5001-10000"
+	CompanySize10001Plus   CompanySize = "10001+"
+)
+
+// Allowed values per endpoint, in documentation order.
+var (
+	jobTypes           = []JobType{JobTypeFullTime, JobTypePartTime, JobTypeContract, JobTypeTemporary, JobTypeInternship, JobTypeVolunteer}
+	jobTypesV2         = append(jobTypes[:len(jobTypes):len(jobTypes)], JobTypeOther)
+	experienceLevels   = []ExperienceLevel{ExperienceInternship, ExperienceEntryLevel, ExperienceAssociate, ExperienceMidSenior, ExperienceDirector}
+	experienceLevelsV2 = append(experienceLevels[:len(experienceLevels):len(experienceLevels)], ExperienceExecutive)
+	timePosted         = []TimePosted{TimePostedAny, TimePosted24h, TimePostedWeek, TimePostedMonth}
+	timePostedV2       = []TimePosted{TimePosted24h, TimePostedWeek, TimePostedMonth}
+	salaries           = []Salary{SalaryAny, Salary40k, Salary60k, Salary80k, Salary100k, Salary120k}
+	salariesV2         = []Salary{Salary20k, Salary30k, Salary40k, Salary50k, Salary60k, Salary70k, Salary80k, Salary90k, Salary100k}
+	workArrangements   = []WorkArrangement{WorkOnsite, WorkRemote, WorkHybrid}
+	benefits           = []Benefit{BenefitMedicalInsurance, BenefitDentalInsurance, BenefitVisionInsurance, Benefit401k, BenefitPension, BenefitPaidMaternity, BenefitPaidPaternity, BenefitCommuter, BenefitStudentLoan, BenefitTuition, BenefitDisabilityInsurance}
+	commitments        = []Commitment{CommitmentDEI, CommitmentEnvironmental, CommitmentWorkLife, CommitmentSocialImpact, CommitmentCareerGrowth}
+	sortOrders         = []SortBy{SortByRelevance, SortByDatePosted}
+	postContentTypes   = []PostContentType{PostContentVideos, PostContentPhotos, PostContentJobs, PostContentLiveVideos, PostContentDocuments, PostContentCollaborativeArticles}
+	postDatePosted     = []PostDatePosted{PostPast24h, PostPastWeek, PostPastMonth, PostPastYear}
+	companySizes       = []CompanySize{CompanySize1To10, CompanySize11To50, CompanySize51To200, CompanySize201To500, CompanySize501To1000, CompanySize1001To5000, CompanySize5001To10000, CompanySize10001Plus}
+)
@@ -0,0 +1,177 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CSVWriter writes records as CSV rows, flattening them to the configured
+// columns. Create one with NewCSVWriter.
+type CSVWriter struct {
+	w       *csv.Writer
+	opts    CSVOptions
+	columns [][]string // Split Columns; nil until the first record without Columns
+	started bool       // Header written or skipped
+}
+
+// NewCSVWriter creates a CSV writer on w. Call Flush when done.
+func NewCSVWriter(w io.Writer, opts *CSVOptions) *CSVWriter {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+	c := &CSVWriter{w: csv.NewWriter(w), opts: *opts}
+	if c.opts.ListSeparator == "" {
+		c.opts.ListSeparator = "; "
+	}
+	if c.opts.Comma != 0 {
+		c.w.Comma = c.opts.Comma
+	}
+	c.opts.Columns = append([]string(nil), c.opts.Columns...)
+	for _, col := range c.opts.Columns {
+		c.columns = append(c.columns, splitPath(col))
+	}
+	return c
+}
+
+// Columns returns the columns written: the configured ones, or those found
+// in the first record. It is empty before the first Write without Columns.
+func (c *CSVWriter) Columns() []string {
+	return c.opts.Columns
+}
+
+// Write writes record as one row, preceded by the header row on the first call.
+func (c *CSVWriter) Write(record any) error {
+	m, err := object(record)
+	if err != nil {
+		return err
+	}
+	if !c.started {
+		if len(c.opts.Columns) == 0 {
+			c.opts.Columns = leafPaths(m)
+			for _, col := range c.opts.Columns {
+				c.columns = append(c.columns, splitPath(col))
+			}
+		}
+		c.started = true
+		if !c.opts.NoHeader {
+			if err := c.write(append([]string(nil), c.opts.Columns...)); err != nil {
+				return err
+			}
+		}
+	}
+
+	row := make([]string, len(c.columns))
+	for i, path := range c.columns {
+		row[i] = strings.Join(lookup(m, path), c.opts.ListSeparator)
+	}
+	return c.write(row)
+}
+
+// write writes row, guarding its fields against formula injection unless
+// NoFormulaGuard is set.
+func (c *CSVWriter) write(row []string) error {
+	if !c.opts.NoFormulaGuard {
+		for i, field := range row {
+			row[i] = guard(field)
+		}
+	}
+	return c.w.Write(row)
+}
+
+// guard prefixes field with a single quote if a spreadsheet would evaluate
+// it as a formula. Numbers, such as negative counts, are left alone.
+func guard(field string) string {
+	if field == "" || !strings.ContainsRune("=+-@\t\r", rune(field[0])) {
+		return field
+	}
+	if _, err := strconv.ParseFloat(field, 64); err == nil {
+		return field
+	}
+	return "'" + field
+}
+
+// WriteResponse writes every record of resp, one row each.
+func (c *CSVWriter) WriteResponse(resp map[string]any) error {
+	records, err := Records(resp)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if err := c.Write(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes buffered rows to the underlying writer.
+func (c *CSVWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// lookup returns the values at path in v. A key applied to a list applies to
+// each of its elements, and a number selects one element, so a path through
+// nested lists can yield many values.
+func lookup(v any, path []string) []string {
+	if len(path) == 0 {
+		switch v := v.(type) {
+		case nil:
+			return nil
+		case []any:
+			// A list of scalars is flattened; anything else is kept as JSON
+			var values []string
+			for _, e := range v {
+				switch e.(type) {
+				case map[string]any, []any:
+					return []string{format(v)}
+				}
+				if s := format(e); s != "" {
+					values = append(values, s)
+				}
+			}
+			return values
+		}
+		return []string{format(v)}
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		return lookup(v[path[0]], path[1:])
+	case []any:
+		if i, err := strconv.Atoi(path[0]); err == nil {
+			if i < 0 || i >= len(v) {
+				return nil
+			}
+			return lookup(v[i], path[1:])
+		}
+		var values []string
+		for _, e := range v {
+			values = append(values, lookup(e, path)...)
+		}
+		return values
+	}
+	return nil
+}
+
+// leafPaths returns the sorted paths to the scalars and lists in m, so
+// nested objects become dotted columns and lists are joined in one.
+func leafPaths(m map[string]any) []string {
+	var paths []string
+	var walk func(prefix string, m map[string]any)
+	walk = func(prefix string, m map[string]any) {
+		for k, v := range m {
+			if nested, ok := v.(map[string]any); ok && len(nested) > 0 {
+				walk(prefix+k+".", nested)
+				continue
+			}
+			paths = append(paths, prefix+k)
+		}
+	}
+	walk("", m)
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,244 @@
+// Package export writes API results to CSV and JSON Lines, one record at a
+// time, so large exports never have to be held in memory.
+//
+// Basic usage:
+//
+//	w := export.NewCSVWriter(os.Stdout, &export.CSVOptions{
+//	    Columns: export.JobColumns,
+//	})
+//	for start := 0; start < 1000; start += 25 {
+//	    resp, err := client.SearchJobsV2(linkdapi.JobSearchV2Params{Keyword: "golang", Start: linkdapi.Int(start)})
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    if err := w.WriteResponse(resp); err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+//	if err := w.Flush(); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Records are the objects listed in a response's data, or any value that
+// encodes to a JSON object, such as a snapshot.Snapshot or an
+// audience.Engager. Write the data of a single-entity response with Record:
+//
+//	record, err := export.Record(resp)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	w.Write(record)
+//
+// CSV fields that a spreadsheet would evaluate as a formula are prefixed with
+// a single quote unless CSVOptions.NoFormulaGuard is set.
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/internal/apiutil"
+)
+
+// Writer is implemented by CSVWriter and JSONLWriter.
+type Writer interface {
+	// Write writes one record.
+	Write(record any) error
+
+	// WriteResponse writes every record of a response, as returned by Records.
+	WriteResponse(resp map[string]any) error
+
+	// Flush writes any buffered data to the underlying writer.
+	Flush() error
+}
+
+var (
+	_ Writer = (*CSVWriter)(nil)
+	_ Writer = (*JSONLWriter)(nil)
+)
+
+// ErrNotObject is returned by CSVWriter.Write for a record that does not
+// encode to a JSON object, and by Record for a response whose data is not
+// an object.
+var ErrNotObject = errors.New("export: record is not an object")
+
+// Column presets for common results. Columns are paths into a record: keys
+// separated by dots, where a key applied to a list applies to each element
+// and a number selects one element. Fields missing from a record are empty.
+var (
+	ProfileColumns = []string{"urn", "publicIdentifier", "firstName", "lastName", "headline",
+		"location", "industry", "followerCount", "connectionsCount", "experience.title", "experience.companyName",
+		"education.schoolName", "skills.name"}
+	CompanyColumns = []string{"id", "name", "universalName", "website", "industry", "description",
+		"staffCount", "followerCount", "headquarters.city", "headquarters.country", "specialities"}
+	JobColumns = []string{"jobId", "title", "company.name", "location", "workplaceType", "employmentType",
+		"postedAt", "applicants", "url"}
+	PostColumns = []string{"urn", "author.name", "text", "postedAt", "reactionsCount", "commentsCount",
+		"repostsCount", "url"}
+	CommentColumns = []string{"urn", "author.name", "author.publicIdentifier", "text", "createdAt",
+		"reactionsCount", "repliesCount"}
+)
+
+// ErrNoRecords is returned by Records for a response whose data is not a
+// list and holds none under the keys tried. Use Record for responses
+// describing a single entity.
+var ErrNoRecords = errors.New("export: response lists no records")
+
+// Records returns the records of a response: the objects listed in its
+// data, either directly or under the first of keys holding a list. Without
+// keys, common list keys such as "items", "profiles" and "jobs" are tried.
+// A response without data has no records.
+func Records(resp map[string]any, keys ...string) ([]map[string]any, error) {
+	data, err := apiutil.Data(resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		keys = []string{"items", "elements", "results", "profiles", "people", "companies",
+			"jobs", "posts", "comments", "likes", "reactions", "services"}
+	}
+
+	list := apiutil.List(data, keys...)
+	if list == nil && data != nil {
+		return nil, fmt.Errorf("%w: data is %s", ErrNoRecords, kind(data))
+	}
+	return apiutil.Objects(list), nil
+}
+
+// Record returns the data object of a response describing a single entity,
+// such as GetProfileOverview, as one record.
+func Record(resp map[string]any) (map[string]any, error) {
+	data, err := apiutil.Data(resp)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: data is %s", ErrNotObject, kind(data))
+	}
+	return m, nil
+}
+
+// kind describes the JSON type of data for errors.
+func kind(data any) string {
+	switch data.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "an object without a known list"
+	case []any:
+		return "a list"
+	}
+	return fmt.Sprintf("a %T", data)
+}
+
+// CSVOptions configures a CSVWriter.
+type CSVOptions struct {
+	// Columns are the paths written, in order (default: the leaf paths of the
+	// first record, sorted). Paths not in a record are written empty.
+	Columns []string
+
+	// ListSeparator joins the values of a path that crosses a list (default: "; ")
+	ListSeparator string
+
+	// NoHeader omits the header row naming the columns (default: false)
+	NoHeader bool
+
+	// Comma is the field delimiter (default: ',')
+	Comma rune
+
+	// NoFormulaGuard writes fields starting with '=', '+', '-', '@', a tab
+	// or a carriage return as they are. By default such fields, unless they
+	// are numbers, are prefixed with a single quote so that spreadsheets
+	// show them as text instead of evaluating them as formulas.
+	NoFormulaGuard bool
+}
+
+// JSONLWriter writes one JSON object per line. Create one with NewJSONLWriter.
+type JSONLWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewJSONLWriter creates a JSON Lines writer on w. Call Flush when done.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	return &JSONLWriter{w: bw, enc: enc}
+}
+
+// Write writes record as one line.
+func (j *JSONLWriter) Write(record any) error {
+	if err := j.enc.Encode(record); err != nil {
+		return fmt.Errorf("export: failed to encode record: %w", err)
+	}
+	return nil
+}
+
+// WriteResponse writes every record of resp, one per line.
+func (j *JSONLWriter) WriteResponse(resp map[string]any) error {
+	records, err := Records(resp)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if err := j.Write(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes buffered lines to the underlying writer.
+func (j *JSONLWriter) Flush() error {
+	return j.w.Flush()
+}
+
+// object returns record as a map, encoding it through JSON if it is not one.
+func object(record any) (map[string]any, error) {
+	if m, ok := record.(map[string]any); ok {
+		return m, nil
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("export: failed to encode record: %w", err)
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil || m == nil {
+		return nil, fmt.Errorf("%w: %T", ErrNotObject, record)
+	}
+	return m, nil
+}
+
+// format returns a scalar as a CSV field. JSON numbers are formatted without
+// an exponent, so numeric IDs survive; objects are written as JSON.
+func format(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// splitPath splits a column path into its keys.
+func splitPath(path string) []string {
+	return strings.Split(path, ".")
+}
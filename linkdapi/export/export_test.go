@@ -0,0 +1,163 @@
+package export
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+)
+
+func ok(data any) map[string]any {
+	return map[string]any{"success": true, "data": data}
+}
+
+func TestRecords(t *testing.T) {
+	a, b := map[string]any{"id": "a"}, map[string]any{"id": "b"}
+	tests := []struct {
+		name string
+		resp map[string]any
+		keys []string
+		want []map[string]any
+		err  error
+	}{
+		{"data list", ok([]any{a, "skipped", b}), nil, []map[string]any{a, b}, nil},
+		{"known key", ok(map[string]any{"total": 2.0, "jobs": []any{a, b}}), nil, []map[string]any{a, b}, nil},
+		{"empty list", ok(map[string]any{"posts": []any{}}), nil, []map[string]any{}, nil},
+		{"given key", ok(map[string]any{"jobs": []any{a}, "hits": []any{b}}), []string{"hits"}, []map[string]any{b}, nil},
+		{"no data", ok(nil), nil, []map[string]any{}, nil},
+		{"object without list", ok(map[string]any{"fullName": "Ryan", "skills": []any{a}}), nil, nil, ErrNoRecords},
+		{"scalar", ok("done"), nil, nil, ErrNoRecords},
+		{"unsuccessful", map[string]any{"success": false, "message": "nope"}, nil, nil, linkdapi.ErrUnsuccessful},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Records(tt.resp, tt.keys...)
+			if !errors.Is(err, tt.err) || tt.err == nil && err != nil {
+				t.Fatalf("Records err = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Records = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	profile := map[string]any{"fullName": "Ryan"}
+	if got, err := Record(ok(profile)); err != nil || !reflect.DeepEqual(got, profile) {
+		t.Errorf("Record = %v, %v; want the data object", got, err)
+	}
+	if _, err := Record(ok([]any{profile})); !errors.Is(err, ErrNotObject) {
+		t.Errorf("Record of a list: err = %v, want ErrNotObject", err)
+	}
+}
+
+// writeCSV writes records with opts and returns the output.
+func writeCSV(t *testing.T, opts *CSVOptions, records ...any) string {
+	t.Helper()
+
+	var b strings.Builder
+	w := NewCSVWriter(&b, opts)
+	for _, r := range records {
+		if err := w.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestCSVPaths(t *testing.T) {
+	profile := map[string]any{
+		"name": "Ryan",
+		"experience": []any{
+			map[string]any{"title": "CEO", "company": map[string]any{"name": "LinkedIn", "tags": []any{"tech", "social"}}},
+			map[string]any{"title": "SVP", "company": map[string]any{"name": "LinkedIn"}},
+		},
+		"skills":    []any{"Go", "Leadership"},
+		"languages": []any{map[string]any{"name": "English"}},
+		"followers": 812345.0,
+	}
+	got := writeCSV(t, &CSVOptions{Columns: []string{
+		"name", "experience.title", "experience.company.tags", "experience.1.title",
+		"experience.5.title", "skills", "languages", "followers", "missing.path",
+	}}, profile)
+	want := "name,experience.title,experience.company.tags,experience.1.title,experience.5.title,skills,languages,followers,missing.path\n" +
+		`Ryan,CEO; SVP,tech; social,SVP,,Go; Leadership,"[{""name"":""English""}]",812345,` + "\n"
+	if got != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCSVDefaultColumns(t *testing.T) {
+	got := writeCSV(t, &CSVOptions{ListSeparator: "|"},
+		map[string]any{"b": 1.0, "a": map[string]any{"y": []any{"p", "q"}, "x": true}, "c": map[string]any{}},
+		map[string]any{"a": map[string]any{"x": false}, "extra": "dropped"},
+	)
+	want := "a.x,a.y,b,c\n" +
+		"true,p|q,1,{}\n" +
+		"false,,,\n"
+	if got != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCSVFormulaGuard(t *testing.T) {
+	record := map[string]any{"a": "=HYPERLINK(\"http://x\")", "b": "+1 555", "c": "-12.5", "d": "@SUM(A1)", "e": "plain"}
+	columns := []string{"a", "b", "c", "d", "e"}
+
+	got := writeCSV(t, &CSVOptions{Columns: columns, NoHeader: true}, record)
+	if want := `"'=HYPERLINK(""http://x"")",'+1 555,-12.5,'@SUM(A1),plain` + "\n"; got != want {
+		t.Errorf("guarded CSV = %s, want %s", got, want)
+	}
+	got = writeCSV(t, &CSVOptions{Columns: columns, NoHeader: true, NoFormulaGuard: true}, record)
+	if want := `"=HYPERLINK(""http://x"")",+1 555,-12.5,@SUM(A1),plain` + "\n"; got != want {
+		t.Errorf("unguarded CSV = %s, want %s", got, want)
+	}
+}
+
+func TestWriteResponseNoRecords(t *testing.T) {
+	var b strings.Builder
+	w := NewCSVWriter(&b, nil)
+	if err := w.WriteResponse(ok(map[string]any{"fullName": "Ryan"})); !errors.Is(err, ErrNoRecords) {
+		t.Errorf("WriteResponse err = %v, want ErrNoRecords", err)
+	}
+	w.Flush()
+	if b.Len() != 0 {
+		t.Errorf("wrote %q for a response without records", b.String())
+	}
+}
+
+func TestJSONL(t *testing.T) {
+	var b strings.Builder
+	w := NewJSONLWriter(&b)
+	resp := ok(map[string]any{"jobs": []any{
+		map[string]any{"jobId": "1", "title": "Go <Engineer> & co"},
+		map[string]any{"jobId": "2"},
+	}})
+	if err := w.WriteResponse(resp); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(struct {
+		Name string `json:"name"`
+	}{"Ryan"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"jobId":"1","title":"Go <Engineer> & co"}` + "\n" +
+		`{"jobId":"2"}` + "\n" +
+		`{"name":"Ryan"}` + "\n"
+	if b.String() != want {
+		t.Errorf("JSONL =\n%s\nwant\n%s", b.String(), want)
+	}
+	if err := w.Write(func() {}); err == nil {
+		t.Error("Write of an unencodable value succeeded")
+	}
+}
@@ -0,0 +1,303 @@
+package linkdapi
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func FuzzEncodeQuery(f *testing.F) {
+	f.Add("software engineer", "San Francisco, CA", "1441", "1035", 0)
+	f.Add("", "", "", "", 25)
+	f.Add("développeur", "Zürich", "a,b", "c", -1)
+	f.Add("c++ & go", "東京", "=", "&", 1<<30)
+	f.Add("%zz", "\x00\xff", " ", "+", 10)
+
+	f.Fuzz(func(t *testing.T, keyword, location, id1, id2 string, start int) {
+		params := make(map[string]string)
+		stringParam(params, "keyword", keyword)
+		stringParam(params, "location", location)
+		sliceParam(params, "companyIds", []string{id1, id2})
+		intParam(params, "start", &start)
+
+		encoded := encodeQuery(params)
+
+		decoded, err := url.ParseQuery(encoded)
+		if err != nil {
+			t.Fatalf("encoded query %q does not parse: %v", encoded, err)
+		}
+
+		// Every parameter survives encoding unchanged
+		if len(decoded) != len(params) {
+			t.Fatalf("decoded %d keys, want %d: %q", len(decoded), len(params), encoded)
+		}
+		for key, value := range params {
+			if got := decoded[key]; len(got) != 1 || got[0] != value {
+				t.Fatalf("decoded[%q] = %q, want %q", key, got, value)
+			}
+		}
+
+		// Encoding is canonical: re-encoding the decoded query is a no-op
+		if again := decoded.Encode(); again != encoded {
+			t.Fatalf("re-encoded query %q differs from %q", again, encoded)
+		}
+	})
+}
+
+func FuzzSliceParam(f *testing.F) {
+	f.Add("full_time", "contract")
+	f.Add("a,b", "c")
+	f.Add("", "")
+	f.Add("ü", ",")
+
+	f.Fuzz(func(t *testing.T, a, b string) {
+		params := make(map[string]string)
+		sliceParam(params, "values", []string{a, b})
+
+		got, ok := params["values"]
+		if !ok {
+			t.Fatal("non-empty slice produced no parameter")
+		}
+		// Values are joined verbatim; commas inside values cannot be told
+		// apart from separators, so the API sees the same string we built.
+		if want := a + "," + b; got != want {
+			t.Fatalf("values = %q, want %q", got, want)
+		}
+		if !strings.HasPrefix(got, a) || !strings.HasSuffix(got, b) {
+			t.Fatalf("values = %q lost an element", got)
+		}
+	})
+}
+
+// captureTransport records the last request and answers with an empty envelope.
+type captureTransport struct {
+	req *http.Request
+}
+
+func (c *captureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.req = req
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader(`{"success":true,"data":{}}`)),
+		Request:    req,
+	}, nil
+}
+
+func FuzzIdentifierQuery(f *testing.F) {
+	f.Add("ryanroslansky")
+	f.Add("ACoAAAEkwwAB9KEc2TrQgOLEQ-vzRyZeCDyc6DQ")
+	f.Add("urn:li:fsd_profile:ACoAAAEkwwAB9KEc2TrQgOLEQ-vzRyZeCDyc6DQ")
+	f.Add("josé-garcía")
+	f.Add("a/b?c=d#e")
+	f.Add("")
+
+	f.Fuzz(func(t *testing.T, id string) {
+		transport := &captureTransport{}
+		client := NewClientWithConfig("test_key", &Config{
+			BaseURL:   "https://linkdapi.test",
+			Transport: transport,
+		})
+		defer client.Close()
+
+		username, parseErr := ParseUsername(id)
+		_, err := client.GetProfileOverview(id)
+		if parseErr != nil {
+			// Invalid identifiers fail before a request is spent
+			if !errors.Is(err, ErrInvalidIdentifier) {
+				t.Fatalf("GetProfileOverview(%q) err = %v, want ErrInvalidIdentifier", id, err)
+			}
+			if transport.req != nil {
+				t.Fatalf("GetProfileOverview(%q) sent a request for an invalid username", id)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("GetProfileOverview(%q): %v", id, err)
+		}
+		if got := transport.req.URL.Path; got != "/api/v1/profile/overview" {
+			t.Fatalf("path = %q, identifier leaked into the path", got)
+		}
+		if got := transport.req.URL.Query().Get("username"); got != username.String() {
+			t.Fatalf("username = %q, want %q", got, username)
+		}
+	})
+}
+
+// fuzzParse checks that parse never panics, that its output is stable when
+// parsed again and that invalid input is reported as ErrInvalidIdentifier.
+func fuzzParse[T ~string](t *testing.T, input string, parse func(string) (T, error)) {
+	got, err := parse(input)
+	if err != nil {
+		if !errors.Is(err, ErrInvalidIdentifier) {
+			t.Fatalf("parse(%q) err = %v, want ErrInvalidIdentifier", input, err)
+		}
+		if got != "" {
+			t.Fatalf("parse(%q) = %q with error", input, got)
+		}
+		return
+	}
+	again, err := parse(string(got))
+	if err != nil {
+		t.Fatalf("parse(%q) = %q, which does not parse: %v", input, got, err)
+	}
+	if again != got {
+		t.Fatalf("parse(%q) = %q, but parse(%q) = %q", input, got, got, again)
+	}
+}
+
+func FuzzParseProfileURN(f *testing.F) {
+	f.Add("ACoAAAEkwwAB9KEc2TrQgOLEQ-vzRyZeCDyc6DQ")
+	f.Add("urn:li:fsd_profile:ACoAAAEkwwAB9KEc2TrQgOLEQ-vzRyZeCDyc6DQ")
+	f.Add("urn:li:fs_miniProfile:ACwAAAEkwwAB9KEc2TrQgOLEQ-vzRyZeCDyc6DQ")
+	f.Add("ryanroslansky")
+	f.Add("urn:li:fsd_profile:")
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParse(t, s, ParseProfileURN)
+		if u, err := ParseProfileURN(s); err == nil {
+			if _, err := ParseProfileURN(u.URN()); err != nil {
+				t.Fatalf("URN() = %q does not parse: %v", u.URN(), err)
+			}
+			if _, err := ParseUsername(s); err == nil {
+				t.Fatalf("%q parses as both a profile URN and a username", s)
+			}
+		}
+	})
+}
+
+func FuzzParsePostURN(f *testing.F) {
+	f.Add("7216040005151268864")
+	f.Add("urn:li:activity:7216040005151268864")
+	f.Add("urn:li:ugcPost:7216040005151268864")
+	f.Add("urn:li:share:")
+	f.Add("７２１６")
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParse(t, s, ParsePostURN)
+		if u, err := ParsePostURN(s); err == nil {
+			if again, err := ParsePostURN(u.URN()); err != nil || again != u {
+				t.Fatalf("URN() = %q parses to %q, %v; want %q", u.URN(), again, err, u)
+			}
+		}
+	})
+}
+
+func FuzzParseCommentURN(f *testing.F) {
+	f.Add("7216041234567890123")
+	f.Add("urn:li:comment:(activity:7216040005151268864,7216041234567890123)")
+	f.Add("urn:li:comment:(urn:li:activity:7216040005151268864,7216041234567890123)")
+	f.Add("urn:li:comment:(activity:1,)")
+	f.Add("urn:li:comment:(")
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParse(t, s, ParseCommentURN)
+	})
+}
+
+func FuzzParseCompanyID(f *testing.F) {
+	f.Add("1441")
+	f.Add("urn:li:company:1441")
+	f.Add("urn:li:organization:1441")
+	f.Add("google")
+	f.Add("-1")
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParse(t, s, ParseCompanyID)
+	})
+}
+
+func FuzzParseJobID(f *testing.F) {
+	f.Add("4012345678")
+	f.Add("urn:li:jobPosting:4012345678")
+	f.Add("urn:li:fsd_jobPosting:")
+	f.Add("123456789012345678901")
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParse(t, s, ParseJobID)
+	})
+}
+
+func FuzzParseUsername(f *testing.F) {
+	f.Add("ryanroslansky")
+	f.Add("@satyanadella")
+	f.Add("josé-garcía")
+	f.Add("a/b?c=d#e")
+	f.Add(" ")
+	f.Add("@@x")
+	f.Fuzz(func(t *testing.T, s string) {
+		fuzzParse(t, s, ParseUsername)
+	})
+}
+
+func FuzzParseLinkedInURL(f *testing.F) {
+	f.Add("https://www.linkedin.com/in/ryanroslansky/")
+	f.Add("linkedin.com/company/google/about")
+	f.Add("https://www.linkedin.com/jobs/view/software-engineer-4012345678/")
+	f.Add("https://www.linkedin.com/jobs/search/?currentJobId=4012345678")
+	f.Add("https://www.linkedin.com/posts/x_y-activity-7216040005151268864-AbCd")
+	f.Add("https://www.linkedin.com/feed/update/urn:li:activity:7216040005151268864/")
+	f.Add("https://www.linkedin.com/pulse/some-article/")
+	f.Add("https://www.linkedin.com/services/page/123abc/")
+	f.Add("https://example.com/in/x")
+	f.Add("%")
+	f.Fuzz(func(t *testing.T, raw string) {
+		u, err := ParseLinkedInURL(raw)
+		if err != nil {
+			if !errors.Is(err, ErrInvalidURL) {
+				t.Fatalf("ParseLinkedInURL(%q) err = %v, want ErrInvalidURL", raw, err)
+			}
+			return
+		}
+		if u.Kind == URLUnknown {
+			t.Fatalf("ParseLinkedInURL(%q) returned an unknown kind without error", raw)
+		}
+
+		// The canonical URL parses to the same identifiers
+		again, err := ParseLinkedInURL(u.URL)
+		if err != nil {
+			t.Fatalf("canonical URL %q of %q does not parse: %v", u.URL, raw, err)
+		}
+		if *again != *u {
+			t.Fatalf("canonical URL %q parses to %+v, want %+v", u.URL, *again, *u)
+		}
+	})
+}
+
+func FuzzDecodeResponse(f *testing.F) {
+	f.Add([]byte(`{"success":true,"statusCode":200,"message":"ok","errors":null,"data":{"fullName":"Ryan"}}`))
+	f.Add([]byte(`{"success":false,"message":"Profile not found","data":null}`))
+	f.Add([]byte(`{"data":[1,2.5,-3e10,"x",true,null,{"a":[]}]}`))
+	f.Add([]byte(`null`))
+	f.Add([]byte(`[]`))
+	f.Add([]byte(`"string"`))
+	f.Add([]byte(`{"a":1e400}`))
+	f.Add([]byte(`{"é":"\xff"}`))
+	f.Add([]byte(``))
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		result, err := decodeResponse(body)
+		if err != nil {
+			if result != nil {
+				t.Fatalf("decodeResponse returned a result with error %v", err)
+			}
+			return
+		}
+		if result == nil {
+			t.Fatal("decodeResponse returned nil result without error")
+		}
+
+		// Decoded responses round-trip through JSON unchanged
+		encoded, err := json.Marshal(result)
+		if err != nil {
+			t.Fatalf("re-encoding decoded response: %v", err)
+		}
+		again, err := decodeResponse(encoded)
+		if err != nil {
+			t.Fatalf("decoding re-encoded response %s: %v", encoded, err)
+		}
+		if !reflect.DeepEqual(result, again) {
+			t.Fatalf("round trip changed response:\n%v\n%v", result, again)
+		}
+	})
+}
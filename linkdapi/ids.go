@@ -0,0 +1,225 @@
+package linkdapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidIdentifier is returned when a URN, ID or username is malformed.
+// Client methods validate identifiers before sending a request, so no
+// credits are spent on calls that cannot succeed.
+var ErrInvalidIdentifier = errors.New("invalid identifier")
+
+// ProfileURN identifies a profile, e.g. "ACoAAAEkwwAB9KEc2TrQgOLEQ-vzRyZeCDyc6DQ".
+type ProfileURN string
+
+// PostURN identifies a post. Activity posts are held as the bare activity ID,
+// e.g. "7216040005151268864"; shares and UGC posts keep their full URN.
+type PostURN string
+
+// CommentURN identifies a comment by its comment ID, e.g. "7216041234567890123".
+type CommentURN string
+
+// CompanyID identifies a company by its numeric ID, e.g. "1441".
+type CompanyID string
+
+// JobID identifies a job posting by its numeric ID, e.g. "4012345678".
+type JobID string
+
+// Username is a profile's public identifier, e.g. "ryanroslansky".
+type Username string
+
+// ParseProfileURN parses a profile URN. It accepts the bare ID as returned by
+// GetProfileURN and the prefixed forms "urn:li:fsd_profile:<id>",
+// "urn:li:fs_profile:<id>", "urn:li:fs_miniProfile:<id>" and
+// "urn:li:profile:<id>". The result is the bare ID.
+func ParseProfileURN(s string) (ProfileURN, error) {
+	id, _ := trimURN(s, "fsd_profile", "fs_profile", "fs_miniProfile", "profile")
+	if !isProfileID(id) {
+		return "", invalid(s, "profile URN")
+	}
+	return ProfileURN(id), nil
+}
+
+// String returns the bare profile ID.
+func (u ProfileURN) String() string { return string(u) }
+
+// URN returns the prefixed form "urn:li:fsd_profile:<id>".
+func (u ProfileURN) URN() string { return "urn:li:fsd_profile:" + string(u) }
+
+// ParsePostURN parses a post URN. It accepts a bare activity ID and
+// "urn:li:activity:<id>", which normalize to the bare ID, as well as
+// "urn:li:share:<id>" and "urn:li:ugcPost:<id>", which are kept in full.
+func ParsePostURN(s string) (PostURN, error) {
+	id, kind := trimURN(s, "activity", "share", "ugcPost")
+	if !isNumeric(id) {
+		return "", invalid(s, "post URN")
+	}
+	if kind == "share" || kind == "ugcPost" {
+		return PostURN("urn:li:" + kind + ":" + id), nil
+	}
+	return PostURN(id), nil
+}
+
+// String returns the post identifier as sent to the API.
+func (u PostURN) String() string { return string(u) }
+
+// URN returns the prefixed form, e.g. "urn:li:activity:<id>".
+func (u PostURN) URN() string {
+	if strings.HasPrefix(string(u), "urn:li:") {
+		return string(u)
+	}
+	return "urn:li:activity:" + string(u)
+}
+
+// ParseCommentURN parses a comment URN. It accepts a bare comment ID and
+// "urn:li:comment:(<parent>,<id>)", where parent is e.g. "activity:123" or
+// "urn:li:activity:123". The result is the bare comment ID.
+func ParseCommentURN(s string) (CommentURN, error) {
+	id := strings.TrimSpace(s)
+	if rest, ok := strings.CutPrefix(id, "urn:li:comment:("); ok {
+		inner, ok := strings.CutSuffix(rest, ")")
+		i := strings.LastIndexByte(inner, ',')
+		if !ok || i < 0 {
+			return "", invalid(s, "comment URN")
+		}
+		kind, parent, _ := strings.Cut(strings.TrimPrefix(inner[:i], "urn:li:"), ":")
+		if kind != "activity" && kind != "ugcPost" && kind != "share" || !isNumeric(parent) {
+			return "", invalid(s, "comment URN")
+		}
+		id = inner[i+1:]
+	}
+	if !isNumeric(id) {
+		return "", invalid(s, "comment URN")
+	}
+	return CommentURN(id), nil
+}
+
+// String returns the bare comment ID.
+func (u CommentURN) String() string { return string(u) }
+
+// ParseCompanyID parses a company ID. It accepts the numeric ID and the forms
+// "urn:li:company:<id>", "urn:li:fsd_company:<id>", "urn:li:organization:<id>"
+// and "urn:li:fs_normalized_company:<id>". The result is the numeric ID.
+func ParseCompanyID(s string) (CompanyID, error) {
+	id, _ := trimURN(s, "company", "fsd_company", "organization", "fs_normalized_company")
+	if !isNumeric(id) {
+		return "", invalid(s, "company ID")
+	}
+	return CompanyID(id), nil
+}
+
+// String returns the numeric company ID.
+func (id CompanyID) String() string { return string(id) }
+
+// URN returns the prefixed form "urn:li:company:<id>".
+func (id CompanyID) URN() string { return "urn:li:company:" + string(id) }
+
+// ParseJobID parses a job ID. It accepts the numeric ID and the forms
+// "urn:li:jobPosting:<id>", "urn:li:fsd_jobPosting:<id>" and
+// "urn:li:fs_normalized_jobPosting:<id>". The result is the numeric ID.
+func ParseJobID(s string) (JobID, error) {
+	id, _ := trimURN(s, "jobPosting", "fsd_jobPosting", "fs_normalized_jobPosting")
+	if !isNumeric(id) {
+		return "", invalid(s, "job ID")
+	}
+	return JobID(id), nil
+}
+
+// String returns the numeric job ID.
+func (id JobID) String() string { return string(id) }
+
+// URN returns the prefixed form "urn:li:jobPosting:<id>".
+func (id JobID) URN() string { return "urn:li:jobPosting:" + string(id) }
+
+// ParseUsername parses a profile's public identifier. A leading "@" is
+// removed. Usernames are at most 100 characters of letters, digits, '-',
+// '_', '.' and '%', and must not look like a profile URN.
+func ParseUsername(s string) (Username, error) {
+	name := strings.TrimPrefix(strings.TrimSpace(s), "@")
+	if name == "" || utf8.RuneCountInString(name) > 100 || !utf8.ValidString(name) {
+		return "", invalid(s, "username")
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-_.%", r) {
+			return "", invalid(s, "username")
+		}
+	}
+	if isProfileID(name) {
+		return "", fmt.Errorf("%w: %q is a profile URN, not a username", ErrInvalidIdentifier, s)
+	}
+	return Username(name), nil
+}
+
+// String returns the username.
+func (u Username) String() string { return string(u) }
+
+// SplitURNs splits a comma-separated list of URNs, keeping commas inside
+// parentheses such as in "urn:li:comment:(activity:1,2)".
+func SplitURNs(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// trimURN strips surrounding whitespace and, if s is "urn:li:<kind>:<id>" for
+// one of kinds, the prefix. It returns the remaining ID and the matched kind.
+func trimURN(s string, kinds ...string) (id, kind string) {
+	s = strings.TrimSpace(s)
+	for _, k := range kinds {
+		if rest, ok := strings.CutPrefix(s, "urn:li:"+k+":"); ok {
+			return rest, k
+		}
+	}
+	return s, ""
+}
+
+// isProfileID reports whether s looks like a bare profile ID: at least 20
+// URL-safe base64 characters starting with "ACoAA" or "ACwAA".
+func isProfileID(s string) bool {
+	if len(s) < 20 || !strings.HasPrefix(s, "ACoAA") && !strings.HasPrefix(s, "ACwAA") {
+		return false
+	}
+	for _, r := range s {
+		if !isBase64URL(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isBase64URL(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_'
+}
+
+func isNumeric(s string) bool {
+	if s == "" || len(s) > 20 {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func invalid(s, what string) error {
+	return fmt.Errorf("%w: %q is not a valid %s", ErrInvalidIdentifier, s, what)
+}
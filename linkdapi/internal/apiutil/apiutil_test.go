@@ -0,0 +1,100 @@
+package apiutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+)
+
+func TestItems(t *testing.T) {
+	a, b := map[string]any{"id": "a"}, map[string]any{"id": "b"}
+	tests := []struct {
+		name string
+		data any
+		keys []string
+		want []map[string]any
+	}{
+		{"data list", []any{a, "skipped", b}, nil, []map[string]any{a, b}},
+		{"caller key", map[string]any{"posts": []any{a}, "items": []any{b}}, []string{"posts"}, []map[string]any{a}},
+		{"default key", map[string]any{"elements": []any{b}}, []string{"posts"}, []map[string]any{b}},
+		{"no list", map[string]any{"total": 2.0}, nil, []map[string]any{}},
+		{"null", nil, nil, []map[string]any{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Items(map[string]any{"success": true, "data": tt.data}, tt.keys...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Items = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Items(map[string]any{"success": false, "message": "nope"}); !errors.Is(err, linkdapi.ErrUnsuccessful) {
+		t.Errorf("unsuccessful response: err = %v, want ErrUnsuccessful", err)
+	}
+}
+
+func TestFirst(t *testing.T) {
+	m := map[string]any{"empty": "  ", "id": 1441.0, "name": " Ada "}
+	for _, tt := range []struct {
+		keys []string
+		want string
+	}{
+		{[]string{"missing", "empty", "name"}, "Ada"},
+		{[]string{"id"}, "1441"},
+		{[]string{"missing"}, ""},
+	} {
+		if got := First(m, tt.keys...); got != tt.want {
+			t.Errorf("First(%v) = %q, want %q", tt.keys, got, tt.want)
+		}
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	notFound := errors.New("test: not found")
+	dir := filepath.Join(t.TempDir(), "state")
+	store := NewFileStore[map[string]int](dir, "test", notFound)
+
+	if _, err := store.Load("a/b"); !errors.Is(err, notFound) {
+		t.Fatalf("Load before Save: err = %v, want notFound", err)
+	}
+	if err := store.Save("a/b", map[string]int{"x": 1}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.Load("a/b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got["x"] != 1 {
+		t.Errorf("Load = %v, want the saved value", got)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 || entries[0].Name() != "a%2Fb.json" {
+		t.Errorf("files = %v, want one escaped file without leftovers", entries)
+	}
+	if info, err := os.Stat(filepath.Join(dir, "a%2Fb.json")); err == nil && info.Mode().Perm() != 0o644 {
+		t.Errorf("mode = %v, want 0644", info.Mode().Perm())
+	}
+}
+
+func TestMemoryStoreClones(t *testing.T) {
+	store := NewMemoryStore(errors.New("test: not found"), CloneMap[map[string]int])
+	v := map[string]int{"x": 1}
+	store.Save("k", v)
+	v["x"] = 2
+
+	got, _ := store.Load("k")
+	got["y"] = 3
+	again, _ := store.Load("k")
+	if !reflect.DeepEqual(again, map[string]int{"x": 1}) {
+		t.Errorf("Load = %v, want the value as saved", again)
+	}
+}
@@ -0,0 +1,86 @@
+// Package apiutil holds helpers shared by the linkdapi subpackages: reading
+// response envelopes and the lists in them, and persisting JSON state.
+package apiutil
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+)
+
+// ListKeys are tried after a caller's own keys when looking for the list in
+// a response's data.
+var ListKeys = []string{"items", "elements", "results"}
+
+// Data returns the "data" of a response envelope, or an error wrapping
+// linkdapi.ErrUnsuccessful if the envelope reports a failure.
+func Data(resp map[string]any) (any, error) {
+	if success, _ := resp["success"].(bool); !success {
+		message, _ := resp["message"].(string)
+		return nil, fmt.Errorf("%w: %s", linkdapi.ErrUnsuccessful, message)
+	}
+	return resp["data"], nil
+}
+
+// Items returns the objects listed in a response, either as its data or
+// under the first of keys, then ListKeys, holding a list in it.
+func Items(resp map[string]any, keys ...string) ([]map[string]any, error) {
+	data, err := Data(resp)
+	if err != nil {
+		return nil, err
+	}
+	return Objects(List(data, slices.Concat(keys, ListKeys)...)), nil
+}
+
+// List returns v if it is a list, or the first of keys holding a list if v
+// is an object.
+func List(v any, keys ...string) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case map[string]any:
+		for _, key := range keys {
+			if l, ok := v[key].([]any); ok {
+				return l
+			}
+		}
+	}
+	return nil
+}
+
+// Objects returns the objects in list, skipping other values.
+func Objects(list []any) []map[string]any {
+	objects := make([]map[string]any, 0, len(list))
+	for _, v := range list {
+		if m, ok := v.(map[string]any); ok {
+			objects = append(objects, m)
+		}
+	}
+	return objects
+}
+
+// First returns the first of keys holding a non-empty String in m.
+func First(m map[string]any, keys ...string) string {
+	for _, key := range keys {
+		if s := String(m[key]); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// String returns v as a string with surrounding space trimmed. JSON numbers
+// are formatted without an exponent, so numeric IDs survive decoding. Other
+// values give "".
+func String(v any) string {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package apiutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// MemoryStore keeps one value of type V per key in memory. Values are
+// cloned on the way in and out, so callers may modify what they pass and get.
+type MemoryStore[V any] struct {
+	notFound error
+	clone    func(V) V
+
+	mu     sync.Mutex
+	values map[string]V
+}
+
+// NewMemoryStore creates an empty store. Load returns notFound for keys
+// never saved.
+func NewMemoryStore[V any](notFound error, clone func(V) V) *MemoryStore[V] {
+	return &MemoryStore[V]{notFound: notFound, clone: clone, values: make(map[string]V)}
+}
+
+// Load returns the value saved for key, or the store's not found error.
+func (m *MemoryStore[V]) Load(key string) (V, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.values[key]
+	if !ok {
+		var zero V
+		return zero, m.notFound
+	}
+	return m.clone(v), nil
+}
+
+// Save replaces the value saved for key.
+func (m *MemoryStore[V]) Save(key string, v V) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.values[key] = m.clone(v)
+	return nil
+}
+
+// CloneMap is a MemoryStore clone function for map values.
+func CloneMap[M ~map[K]E, K comparable, E any](m M) M {
+	return maps.Clone(m)
+}
+
+// FileStore keeps one value of type V per key as a JSON file in a directory,
+// named after the path-escaped key. Files are replaced atomically, so a
+// crash never leaves a partial file.
+type FileStore[V any] struct {
+	dir      string
+	pkg      string // Prefix of error messages, e.g. "jobwatch"
+	notFound error
+
+	mu sync.Mutex
+}
+
+// NewFileStore creates a store in dir, which is created on the first Save.
+// Load returns notFound for keys never saved, and errors are prefixed with
+// pkg.
+func NewFileStore[V any](dir, pkg string, notFound error) *FileStore[V] {
+	return &FileStore[V]{dir: dir, pkg: pkg, notFound: notFound}
+}
+
+// Load returns the value saved for key, or the store's not found error.
+func (f *FileStore[V]) Load(key string) (V, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var v V
+	data, err := os.ReadFile(f.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return v, f.notFound
+	}
+	if err != nil {
+		return v, fmt.Errorf("%s: failed to read %s: %w", f.pkg, key, err)
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return v, fmt.Errorf("%s: failed to decode %s: %w", f.pkg, key, err)
+	}
+	return v, nil
+}
+
+// Save replaces the value saved for key.
+func (f *FileStore[V]) Save(key string, v V) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%s: failed to encode %s: %w", f.pkg, key, err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return fmt.Errorf("%s: failed to create %s: %w", f.pkg, f.dir, err)
+	}
+	tmp, err := os.CreateTemp(f.dir, ".*.tmp")
+	if err != nil {
+		return fmt.Errorf("%s: failed to write %s: %w", f.pkg, key, err)
+	}
+	_, err = tmp.Write(append(data, '\n'))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0o644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("%s: failed to write %s: %w", f.pkg, key, err)
+	}
+	return nil
+}
+
+// path returns the file holding key's value.
+func (f *FileStore[V]) path(key string) string {
+	return filepath.Join(f.dir, url.PathEscape(key)+".json")
+}
@@ -0,0 +1,58 @@
+package apiutil
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Watch calls poll for each target now and then once per interval until ctx
+// is done, sending the events on events, which it closes when it returns.
+// Errors are passed to onError, if set, and do not stop the loop. It returns
+// the context's error.
+func Watch[T, E any](ctx context.Context, interval time.Duration, targets []T, poll func(T) ([]E, error), onError func(T, error), events chan<- E) error {
+	defer close(events)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, t := range targets {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			polled, err := poll(t)
+			if err != nil && onError != nil {
+				onError(t, err)
+			}
+			for _, e := range polled {
+				select {
+				case events <- e:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// PollAll calls poll for each target once and returns all the events. The
+// errors of failed targets, annotated by wrap, are joined; the other targets
+// still run.
+func PollAll[T, E any](targets []T, poll func(T) ([]E, error), wrap func(T, error) error) ([]E, error) {
+	var all []E
+	var errs []error
+	for _, t := range targets {
+		events, err := poll(t)
+		if err != nil {
+			errs = append(errs, wrap(t, err))
+		}
+		all = append(all, events...)
+	}
+	return all, errors.Join(errs...)
+}
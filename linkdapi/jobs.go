@@ -2,10 +2,22 @@ package linkdapi
 
 // Jobs Endpoints
 
-// SearchJobs searches for jobs with various filters.
+// SearchJobs searches for jobs with various filters. Invalid filter values are
+// reported as a *ValidationError before a request is sent.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/jobs/search
-func (c *Client) SearchJobs(searchParams JobSearchParams) (map[string]any, error) {
+func (c *Client) SearchJobs(searchParams JobSearchParams, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart)
+	if err != nil {
+		return nil, err
+	}
+	if o.start != nil {
+		searchParams.Start = unlessZero(*o.start)
+	}
+	if err := searchParams.Validate(); err != nil {
+		return nil, err
+	}
+
 	params := make(map[string]string)
 
 	stringParam(params, "keyword", searchParams.Keyword)
@@ -20,53 +32,98 @@ func (c *Client) SearchJobs(searchParams JobSearchParams) (map[string]any, error
 	sliceParam(params, "workArrangement", searchParams.WorkArrangement)
 	intParam(params, "start", searchParams.Start)
 
-	return c.sendRequest("GET", "api/v1/jobs/search", params)
+	return c.sendRequest("GET", "api/v1/jobs/search", params, o)
 }
 
 // GetJobDetails gets job details by job ID.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/jobs/job/details
-func (c *Client) GetJobDetails(jobID string) (map[string]any, error) {
-	params := map[string]string{"jobId": jobID}
-	return c.sendRequest("GET", "api/v1/jobs/job/details", params)
+func (c *Client) GetJobDetails(jobID string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ParseJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"jobId": id.String()}
+	return c.sendRequest("GET", "api/v1/jobs/job/details", params, o)
 }
 
 // GetSimilarJobs gets similar jobs by job ID.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/jobs/job/similar
-func (c *Client) GetSimilarJobs(jobID string) (map[string]any, error) {
-	params := map[string]string{"jobId": jobID}
-	return c.sendRequest("GET", "api/v1/jobs/job/similar", params)
+func (c *Client) GetSimilarJobs(jobID string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ParseJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"jobId": id.String()}
+	return c.sendRequest("GET", "api/v1/jobs/job/similar", params, o)
 }
 
 // GetPeopleAlsoViewedJobs gets related jobs that people also viewed.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/jobs/job/people-also-viewed
-func (c *Client) GetPeopleAlsoViewedJobs(jobID string) (map[string]any, error) {
-	params := map[string]string{"jobId": jobID}
-	return c.sendRequest("GET", "api/v1/jobs/job/people-also-viewed", params)
+func (c *Client) GetPeopleAlsoViewedJobs(jobID string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ParseJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"jobId": id.String()}
+	return c.sendRequest("GET", "api/v1/jobs/job/people-also-viewed", params, o)
 }
 
 // GetJobDetailsV2 gets job details V2 by job ID. This endpoint supports all job statuses
 // (open, closed, expired, etc.) and provides detailed information about the job.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/jobs/job/details-v2
-func (c *Client) GetJobDetailsV2(jobID string) (map[string]any, error) {
-	params := map[string]string{"jobId": jobID}
-	return c.sendRequest("GET", "api/v1/jobs/job/details-v2", params)
+func (c *Client) GetJobDetailsV2(jobID string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ParseJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"jobId": id.String()}
+	return c.sendRequest("GET", "api/v1/jobs/job/details-v2", params, o)
 }
 
 // SearchJobsV2 searches for jobs V2 with comprehensive filters (all filters available).
+// Invalid filter values are reported as a *ValidationError before a request is sent.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/search/jobs
-func (c *Client) SearchJobsV2(searchParams JobSearchV2Params) (map[string]any, error) {
+func (c *Client) SearchJobsV2(searchParams JobSearchV2Params, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart|acceptsCount)
+	if err != nil {
+		return nil, err
+	}
+	if o.start != nil {
+		searchParams.Start = unlessZero(*o.start)
+	}
+	if o.count != nil {
+		searchParams.Count = unlessZero(*o.count)
+	}
+	if err := searchParams.Validate(); err != nil {
+		return nil, err
+	}
+
 	params := make(map[string]string)
 
 	stringParam(params, "keyword", searchParams.Keyword)
 	intParam(params, "start", searchParams.Start)
-	if searchParams.Count > 0 {
-		intParam(params, "count", searchParams.Count)
-	}
+	intParam(params, "count", searchParams.Count)
 	stringParam(params, "sortBy", searchParams.SortBy)
 	stringParam(params, "datePosted", searchParams.DatePosted)
 	sliceParam(params, "experience", searchParams.Experience)
@@ -85,14 +142,25 @@ func (c *Client) SearchJobsV2(searchParams JobSearchV2Params) (map[string]any, e
 	boolParam(params, "under10Applicants", searchParams.Under10Applicants)
 	boolParam(params, "fairChance", searchParams.FairChance)
 
-	return c.sendRequest("GET", "api/v1/search/jobs", params)
+	return c.sendRequest("GET", "api/v1/search/jobs", params, o)
 }
 
 // GetHiringTeam gets the hiring team for a given job by job ID.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/jobs/job/hiring-team
-func (c *Client) GetHiringTeam(jobID string, start int) (map[string]any, error) {
-	params := map[string]string{"jobId": jobID}
-	intParam(params, "start", start)
-	return c.sendRequest("GET", "api/v1/jobs/job/hiring-team", params)
+func (c *Client) GetHiringTeam(jobID string, start int, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string)
+	if err := startParam(params, o.startOr(start)); err != nil {
+		return nil, err
+	}
+	id, err := ParseJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	params["jobId"] = id.String()
+	return c.sendRequest("GET", "api/v1/jobs/job/hiring-team", params, o)
 }
@@ -0,0 +1,423 @@
+// Package jobwatch polls saved job searches and reports jobs that were newly
+// posted or have closed since the last poll.
+//
+// Basic usage:
+//
+//	w, err := jobwatch.New(client, []jobwatch.Search{
+//	    {Name: "go-remote", Params: &linkdapi.JobSearchV2Params{
+//	        Keyword:        "golang",
+//	        WorkplaceTypes: []linkdapi.WorkArrangement{linkdapi.WorkRemote},
+//	    }},
+//	    {Name: "google", CompanyIDs: []string{"google"}},
+//	}, jobwatch.NewFileStore("jobwatch"), &jobwatch.Options{Interval: 30 * time.Minute})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	go w.Run(ctx)
+//	for e := range w.Events() {
+//	    fmt.Println(e)
+//	}
+//
+// Seen jobs are kept in the Store, so a restarted watcher picks up where it
+// left off. The first poll of a search only records its current jobs, unless
+// Options.EmitInitial is set.
+package jobwatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/internal/apiutil"
+)
+
+// API is the part of the LinkdAPI client the watcher uses. *linkdapi.Client
+// and the linkdapimock.Client implement it.
+type API interface {
+	linkdapi.JobAPI
+	linkdapi.CompanyAPI
+}
+
+// Search is a saved search polled by the watcher. Exactly one of Params and
+// CompanyIDs must be set.
+type Search struct {
+	// Name identifies the search in the Store and in events; it must be unique
+	Name string
+
+	// Params runs SearchJobsV2 with these filters
+	Params *linkdapi.JobSearchV2Params
+
+	// CompanyIDs runs GetCompanyJobs for these companies (IDs, URNs or names)
+	CompanyIDs []string
+}
+
+// Options configures a Watcher.
+type Options struct {
+	// Interval is the time between polls in Run (default: 15 minutes)
+	Interval time.Duration
+
+	// MaxPages is the most result pages fetched per search and poll (default: 5)
+	MaxPages int
+
+	// CloseCheckInterval is how often a job that dropped out of its search
+	// results is checked with GetJobDetailsV2 (default: 24 hours)
+	CloseCheckInterval time.Duration
+
+	// MaxCloseChecks is the most GetJobDetailsV2 calls per search and poll
+	// (default: 20). The jobs checked longest ago go first; the rest wait
+	// for the next poll.
+	MaxCloseChecks int
+
+	// MaxAge is how long a job that dropped out of its search results is
+	// remembered without being listed again (default: 30 days). It is then
+	// forgotten without a JobClosed event.
+	MaxAge time.Duration
+
+	// EmitInitial emits JobPosted for the jobs found by a search's first poll (default: false)
+	EmitInitial bool
+
+	// OnError, if set, is called with errors that do not stop Run, such as a
+	// failed search. The search is retried on the next poll.
+	OnError func(search string, err error)
+}
+
+// EventType is the kind of an Event.
+type EventType string
+
+const (
+	JobPosted EventType = "job_posted" // A job appeared in a search's results
+	JobClosed EventType = "job_closed" // A job seen before is no longer accepting applications
+)
+
+// Event reports a change in a search's jobs.
+type Event struct {
+	Type    EventType
+	Search  string // The Search's Name
+	JobID   linkdapi.JobID
+	Title   string
+	Company string
+	At      time.Time      // When the change was detected
+	Data    map[string]any // The search result for JobPosted, the job details for JobClosed
+}
+
+// String describes the event, e.g. "go-remote: job_posted: 3912345678 Go Engineer at Acme".
+func (e Event) String() string {
+	job := e.Title
+	if e.Company != "" {
+		job += " at " + e.Company
+	}
+	return fmt.Sprintf("%s: %s: %s %s", e.Search, e.Type, e.JobID, job)
+}
+
+// Watcher polls saved searches. Create one with New.
+type Watcher struct {
+	api      API
+	searches []Search
+	store    Store
+	opts     Options
+	events   chan Event
+
+	mu sync.Mutex // Held while polling, so Run and Poll may overlap
+}
+
+// New creates a watcher for searches, remembering seen jobs in store.
+func New(api API, searches []Search, store Store, opts *Options) (*Watcher, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	w := &Watcher{
+		api:      api,
+		searches: searches,
+		store:    store,
+		opts:     *opts,
+		events:   make(chan Event),
+	}
+	if w.opts.Interval <= 0 {
+		w.opts.Interval = 15 * time.Minute
+	}
+	if w.opts.MaxPages <= 0 {
+		w.opts.MaxPages = 5
+	}
+	if w.opts.CloseCheckInterval <= 0 {
+		w.opts.CloseCheckInterval = 24 * time.Hour
+	}
+	if w.opts.MaxCloseChecks <= 0 {
+		w.opts.MaxCloseChecks = 20
+	}
+	if w.opts.MaxAge <= 0 {
+		w.opts.MaxAge = 30 * 24 * time.Hour
+	}
+
+	names := make(map[string]bool, len(searches))
+	for _, s := range searches {
+		switch {
+		case s.Name == "":
+			return nil, errors.New("jobwatch: search without a name")
+		case names[s.Name]:
+			return nil, fmt.Errorf("jobwatch: duplicate search name %q", s.Name)
+		case (s.Params == nil) == (len(s.CompanyIDs) == 0):
+			return nil, fmt.Errorf("jobwatch: search %q must set exactly one of Params and CompanyIDs", s.Name)
+		}
+		names[s.Name] = true
+		if s.Params != nil {
+			if err := s.Params.Validate(); err != nil {
+				return nil, fmt.Errorf("jobwatch: search %q: %w", s.Name, err)
+			}
+		}
+	}
+	return w, nil
+}
+
+// Events returns the channel Run sends events on. It is closed when Run returns.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Run polls every search now and then once per interval until ctx is done,
+// sending events on Events. It returns the context's error.
+func (w *Watcher) Run(ctx context.Context) error {
+	return apiutil.Watch(ctx, w.opts.Interval, w.searches, w.poll, func(s Search, err error) {
+		w.reportError(s.Name, err)
+	}, w.events)
+}
+
+// Poll polls every search once and returns the events, without sending them
+// on Events. Use it to drive the watcher from a scheduler instead of Run.
+// Searches that fail are reported in the joined error; the others still run.
+func (w *Watcher) Poll() ([]Event, error) {
+	return apiutil.PollAll(w.searches, w.poll, func(s Search, err error) error {
+		return fmt.Errorf("jobwatch: search %q: %w", s.Name, err)
+	})
+}
+
+// poll runs one search, compares its results with the stored jobs and saves
+// the updated state. Events found before a failed close check are still
+// returned, but none are if the state cannot be saved, since the next poll
+// finds them again.
+func (w *Watcher) poll(s Search) ([]Event, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	seen, err := w.store.Load(s.Name)
+	initial := errors.Is(err, ErrNotFound)
+	if err != nil && !initial {
+		return nil, err
+	}
+	if seen == nil {
+		seen = make(map[linkdapi.JobID]Job)
+	}
+
+	items, err := w.search(s)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var events []Event
+	current := make(map[linkdapi.JobID]bool, len(items))
+	for _, item := range items {
+		id, job := parseJob(item)
+		if id == "" {
+			continue
+		}
+		current[id] = true
+		prev, known := seen[id]
+		if known {
+			job.FirstSeen = prev.FirstSeen
+		} else {
+			job.FirstSeen = now
+			if !initial || w.opts.EmitInitial {
+				events = append(events, Event{Type: JobPosted, Search: s.Name, JobID: id,
+					Title: job.Title, Company: job.Company, At: now, Data: item})
+			}
+		}
+		job.LastSeen = now
+		seen[id] = job
+	}
+
+	// Jobs that dropped out of the results may have closed, or merely been
+	// pushed off the last page fetched. Check those due, longest unchecked
+	// first, and forget those not listed for MaxAge.
+	var due []linkdapi.JobID
+	for id, job := range seen {
+		switch {
+		case current[id]:
+		case now.Sub(job.LastSeen) > w.opts.MaxAge:
+			delete(seen, id)
+		case now.Sub(job.LastChecked) >= w.opts.CloseCheckInterval:
+			due = append(due, id)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		a, b := seen[due[i]].LastChecked, seen[due[j]].LastChecked
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return due[i] < due[j]
+	})
+	if len(due) > w.opts.MaxCloseChecks {
+		due = due[:w.opts.MaxCloseChecks]
+	}
+
+	var errs []error
+	for _, id := range due {
+		job := seen[id]
+		details, closed, err := w.closed(id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to check job %s: %w", id, err))
+			continue
+		}
+		if closed {
+			events = append(events, Event{Type: JobClosed, Search: s.Name, JobID: id,
+				Title: job.Title, Company: job.Company, At: now, Data: details})
+			delete(seen, id)
+			continue
+		}
+		job.LastChecked = now
+		seen[id] = job
+	}
+
+	if err := w.store.Save(s.Name, seen); err != nil {
+		return nil, err
+	}
+	return events, errors.Join(errs...)
+}
+
+// search fetches the results of s page by page, until a page is short or
+// lists no new job, or MaxPages pages were fetched.
+func (w *Watcher) search(s Search) ([]map[string]any, error) {
+	start, size := 0, 0 // Page size is unknown for company jobs
+	if s.Params != nil {
+		if s.Params.Start != nil {
+			start = *s.Params.Start
+		}
+		size = 25
+		if s.Params.Count != nil {
+			size = *s.Params.Count
+		}
+	}
+
+	var all []map[string]any
+	listed := make(map[linkdapi.JobID]bool)
+	for page := 0; page < w.opts.MaxPages; page++ {
+		var resp map[string]any
+		var err error
+		if s.Params != nil {
+			resp, err = w.api.SearchJobsV2(*s.Params, linkdapi.WithStart(start))
+		} else {
+			resp, err = w.api.GetCompanyJobs(s.CompanyIDs, start)
+		}
+		if err != nil {
+			return nil, err
+		}
+		items, err := apiutil.Items(resp, "jobs")
+		if err != nil {
+			return nil, err
+		}
+
+		fresh := 0
+		for _, item := range items {
+			if id, _ := parseJob(item); id != "" && !listed[id] {
+				listed[id] = true
+				fresh++
+				all = append(all, item)
+			}
+		}
+		if fresh == 0 || len(items) < size {
+			break
+		}
+		start += len(items)
+	}
+	return all, nil
+}
+
+// closed reports whether GetJobDetailsV2 says the job is closed. A job the
+// API no longer knows is closed too, but only if the unsuccessful envelope
+// says so with a 404 or 410 status or its message; other unsuccessful
+// responses, such as a rate limit, are errors so the job is checked again.
+func (w *Watcher) closed(id linkdapi.JobID) (map[string]any, bool, error) {
+	resp, err := w.api.GetJobDetailsV2(id.String())
+	if err != nil {
+		return nil, false, err
+	}
+	if success, _ := resp["success"].(bool); !success {
+		if gone(resp) {
+			return nil, true, nil
+		}
+		_, err := apiutil.Data(resp)
+		return nil, false, err
+	}
+	data, _ := resp["data"].(map[string]any)
+	for _, key := range []string{"closed", "isClosed", "expired"} {
+		if v, ok := data[key].(bool); ok && v {
+			return data, true, nil
+		}
+	}
+	for _, key := range []string{"jobState", "state", "status"} {
+		if v, ok := data[key].(string); ok {
+			switch strings.ToLower(v) {
+			case "closed", "expired", "deleted", "suspended":
+				return data, true, nil
+			}
+		}
+	}
+	if v, ok := data["applyingInfo"].(map[string]any); ok {
+		if closed, _ := v["closed"].(bool); closed {
+			return data, true, nil
+		}
+	}
+	return data, false, nil
+}
+
+// gone reports whether an unsuccessful envelope says its resource no longer
+// exists.
+func gone(resp map[string]any) bool {
+	if status, _ := resp["statusCode"].(float64); status == http.StatusNotFound || status == http.StatusGone {
+		return true
+	}
+	message, _ := resp["message"].(string)
+	message = strings.ToLower(message)
+	for _, s := range []string{"not found", "no longer", "closed", "expired", "does not exist"} {
+		if strings.Contains(message, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Watcher) reportError(search string, err error) {
+	if w.opts.OnError != nil {
+		w.opts.OnError(search, err)
+	}
+}
+
+// parseJob returns the ID and stored summary of a search result, or an
+// empty ID if it has none.
+func parseJob(item map[string]any) (linkdapi.JobID, Job) {
+	var id linkdapi.JobID
+	for _, key := range []string{"jobId", "id", "jobPostingId", "urn", "jobUrn"} {
+		if parsed, err := linkdapi.ParseJobID(apiutil.String(item[key])); err == nil {
+			id = parsed
+			break
+		}
+	}
+
+	job := Job{Title: apiutil.First(item, "title")}
+	switch company := item["company"].(type) {
+	case string:
+		job.Company = apiutil.String(company)
+	case map[string]any:
+		job.Company = apiutil.First(company, "name")
+	}
+	if job.Company == "" {
+		job.Company = apiutil.First(item, "companyName")
+	}
+	return id, job
+}
@@ -0,0 +1,224 @@
+package jobwatch
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapimock"
+)
+
+// jobsPage returns a GetCompanyJobs response listing ids.
+func jobsPage(ids ...string) map[string]any {
+	jobs := make([]any, len(ids))
+	for i, id := range ids {
+		jobs[i] = map[string]any{"jobId": id, "title": "Job " + id, "company": map[string]any{"name": "Acme"}}
+	}
+	return map[string]any{"success": true, "data": map[string]any{"jobs": jobs}}
+}
+
+// companyJobs serves pages from listing, keyed by start offset, and records
+// the offsets requested.
+func companyJobs(mock *linkdapimock.Client, listing map[int][]string, starts *[]int) {
+	mock.GetCompanyJobsFunc = func(_ []string, start int, _ ...linkdapi.RequestOption) (map[string]any, error) {
+		*starts = append(*starts, start)
+		return jobsPage(listing[start]...), nil
+	}
+}
+
+func eventTypes(events []Event) []string {
+	var types []string
+	for _, e := range events {
+		types = append(types, string(e.Type)+" "+e.JobID.String())
+	}
+	return types
+}
+
+func TestPollPostedAndClosed(t *testing.T) {
+	mock := &linkdapimock.Client{}
+	var starts []int
+	companyJobs(mock, map[int][]string{0: {"1", "2"}}, &starts)
+	mock.GetJobDetailsV2Func = func(id string, _ ...linkdapi.RequestOption) (map[string]any, error) {
+		return map[string]any{"success": true, "data": map[string]any{"jobId": id, "jobState": "CLOSED"}}, nil
+	}
+
+	w, err := New(mock, []Search{{Name: "acme", CompanyIDs: []string{"1441"}}}, NewMemoryStore(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events, err := w.Poll(); err != nil || len(events) != 0 {
+		t.Fatalf("first Poll = %v, %v; want no events", eventTypes(events), err)
+	}
+
+	companyJobs(mock, map[int][]string{0: {"2", "3"}}, &starts)
+	events, err := w.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"job_posted 3", "job_closed 1"}
+	if got := eventTypes(events); !reflect.DeepEqual(got, want) {
+		t.Errorf("second Poll = %v, want %v", got, want)
+	}
+	if e := events[0]; e.Title != "Job 3" || e.Company != "Acme" || e.Search != "acme" {
+		t.Errorf("JobPosted = %+v, want the listed job", e)
+	}
+	if calls := mock.GetJobDetailsV2Calls(); len(calls) != 1 {
+		t.Errorf("GetJobDetailsV2 calls = %d, want 1 for the dropped job", len(calls))
+	}
+}
+
+func TestPollUnsuccessfulDetails(t *testing.T) {
+	mock := &linkdapimock.Client{}
+	var starts []int
+	companyJobs(mock, map[int][]string{0: {"1"}}, &starts)
+	mock.On("GetJobDetailsV2",
+		linkdapimock.Result{Response: map[string]any{"success": false, "statusCode": 429.0, "message": "Rate limit exceeded"}},
+		linkdapimock.Result{Response: map[string]any{"success": false, "statusCode": 404.0, "message": "Job not found"}},
+	)
+
+	store := NewMemoryStore()
+	w, err := New(mock, []Search{{Name: "acme", CompanyIDs: []string{"1441"}}}, store, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Poll(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A rate-limited check is an error, not a closed job
+	companyJobs(mock, map[int][]string{}, &starts)
+	events, err := w.Poll()
+	if !errors.Is(err, linkdapi.ErrUnsuccessful) || len(events) != 0 {
+		t.Fatalf("rate-limited Poll = %v, %v; want no events and ErrUnsuccessful", eventTypes(events), err)
+	}
+	if seen, err := store.Load("acme"); err != nil || len(seen) != 1 {
+		t.Fatalf("stored jobs = %v, %v; want the job kept", seen, err)
+	}
+
+	// The job is checked again on the next poll, where not found means closed
+	events, err = w.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := eventTypes(events), []string{"job_closed 1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("not-found Poll = %v, want %v", got, want)
+	}
+}
+
+func TestPollEmitInitial(t *testing.T) {
+	mock := &linkdapimock.Client{}
+	mock.On("SearchJobsV2", linkdapimock.Result{Response: jobsPage("1", "2")})
+
+	w, err := New(mock, []Search{{Name: "go", Params: &linkdapi.JobSearchV2Params{Keyword: "golang"}}},
+		NewMemoryStore(), &Options{EmitInitial: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := w.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := eventTypes(events), []string{"job_posted 1", "job_posted 2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Poll = %v, want %v", got, want)
+	}
+	// The page is shorter than the default page size of 25, so it is the last
+	if calls := mock.SearchJobsV2Calls(); len(calls) != 1 {
+		t.Errorf("SearchJobsV2 calls = %d, want 1", len(calls))
+	}
+}
+
+func TestPollPaging(t *testing.T) {
+	tests := []struct {
+		name     string
+		listing  map[int][]string
+		maxPages int
+		want     []int
+	}{
+		{"until empty page", map[int][]string{0: {"1", "2"}, 2: {"3"}}, 0, []int{0, 2, 3}},
+		{"until repeated page", map[int][]string{0: {"1", "2"}, 2: {"1", "2"}}, 0, []int{0, 2}},
+		{"up to MaxPages", map[int][]string{0: {"1"}, 1: {"2"}, 2: {"3"}}, 2, []int{0, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &linkdapimock.Client{}
+			var starts []int
+			companyJobs(mock, tt.listing, &starts)
+
+			w, err := New(mock, []Search{{Name: "acme", CompanyIDs: []string{"1441"}}},
+				NewMemoryStore(), &Options{EmitInitial: true, MaxPages: tt.maxPages})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := w.Poll(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(starts, tt.want) {
+				t.Errorf("starts = %v, want %v", starts, tt.want)
+			}
+		})
+	}
+}
+
+func TestPollCloseCheckLimits(t *testing.T) {
+	now := time.Now()
+	store := NewMemoryStore()
+	store.Save("acme", map[linkdapi.JobID]Job{
+		"1": {LastSeen: now, LastChecked: now.Add(-48 * time.Hour)},
+		"2": {LastSeen: now, LastChecked: now.Add(-72 * time.Hour)},
+		"3": {LastSeen: now},                           // Never checked, so due first
+		"4": {LastSeen: now.Add(-60 * 24 * time.Hour)}, // Unlisted for longer than MaxAge
+		"5": {LastSeen: now, LastChecked: now},         // Not due
+	})
+
+	mock := &linkdapimock.Client{}
+	var starts []int
+	companyJobs(mock, map[int][]string{}, &starts)
+	mock.On("GetJobDetailsV2", linkdapimock.Result{Response: map[string]any{"success": true, "data": map[string]any{}}})
+
+	w, err := New(mock, []Search{{Name: "acme", CompanyIDs: []string{"1441"}}}, store, &Options{MaxCloseChecks: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events, err := w.Poll(); err != nil || len(events) != 0 {
+		t.Fatalf("Poll = %v, %v; want no events", eventTypes(events), err)
+	}
+
+	var checked []string
+	for _, c := range mock.GetJobDetailsV2Calls() {
+		checked = append(checked, c.Args[0].(string))
+	}
+	if want := []string{"3", "2"}; !reflect.DeepEqual(checked, want) {
+		t.Errorf("checked = %v, want the %v checked longest ago", checked, want)
+	}
+	jobs, _ := store.Load("acme")
+	if _, ok := jobs["4"]; ok {
+		t.Error("job unlisted for longer than MaxAge is still stored")
+	}
+	if len(jobs) != 4 {
+		t.Errorf("stored %d jobs, want 4", len(jobs))
+	}
+}
+
+// failingStore is a Store whose saves fail.
+type failingStore struct{ *MemoryStore }
+
+func (failingStore) Save(string, map[linkdapi.JobID]Job) error {
+	return errors.New("disk full")
+}
+
+func TestPollSaveFailure(t *testing.T) {
+	mock := &linkdapimock.Client{}
+	mock.On("GetCompanyJobs", linkdapimock.Result{Response: jobsPage("1")})
+
+	w, err := New(mock, []Search{{Name: "acme", CompanyIDs: []string{"1441"}}},
+		failingStore{NewMemoryStore()}, &Options{EmitInitial: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := w.Poll()
+	if err == nil || len(events) != 0 {
+		t.Errorf("Poll = %v, %v; want no events and the save error", eventTypes(events), err)
+	}
+}
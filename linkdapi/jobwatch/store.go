@@ -0,0 +1,72 @@
+package jobwatch
+
+import (
+	"errors"
+	"time"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/internal/apiutil"
+)
+
+// ErrNotFound is returned by Store.Load for a search that was never saved.
+var ErrNotFound = errors.New("jobwatch: not found")
+
+// Job is what the watcher remembers about a job it has seen.
+type Job struct {
+	Title       string    `json:"title,omitempty"`
+	Company     string    `json:"company,omitempty"`
+	FirstSeen   time.Time `json:"firstSeen"`
+	LastSeen    time.Time `json:"lastSeen"`              // Last poll that listed the job
+	LastChecked time.Time `json:"lastChecked,omitempty"` // Last time the job was found open by GetJobDetailsV2
+}
+
+// Store keeps the jobs seen by each search.
+//
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the jobs seen by a search, or ErrNotFound if it was never saved.
+	Load(search string) (map[linkdapi.JobID]Job, error)
+
+	// Save replaces the jobs seen by a search.
+	Save(search string, jobs map[linkdapi.JobID]Job) error
+}
+
+// MemoryStore is a Store held in memory. It does not survive restarts.
+type MemoryStore struct {
+	store *apiutil.MemoryStore[map[linkdapi.JobID]Job]
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{store: apiutil.NewMemoryStore(ErrNotFound, apiutil.CloneMap[map[linkdapi.JobID]Job])}
+}
+
+// Load returns the jobs seen by a search, or ErrNotFound.
+func (m *MemoryStore) Load(search string) (map[linkdapi.JobID]Job, error) {
+	return m.store.Load(search)
+}
+
+// Save replaces the jobs seen by a search.
+func (m *MemoryStore) Save(search string, jobs map[linkdapi.JobID]Job) error {
+	return m.store.Save(search, jobs)
+}
+
+// FileStore is a Store writing one JSON file per search to a directory.
+type FileStore struct {
+	store *apiutil.FileStore[map[linkdapi.JobID]Job]
+}
+
+// NewFileStore creates a store in dir, which is created on the first Save.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{store: apiutil.NewFileStore[map[linkdapi.JobID]Job](dir, "jobwatch", ErrNotFound)}
+}
+
+// Load returns the jobs seen by a search, or ErrNotFound.
+func (f *FileStore) Load(search string) (map[linkdapi.JobID]Job, error) {
+	return f.store.Load(search)
+}
+
+// Save replaces the jobs seen by a search. The file is replaced atomically.
+func (f *FileStore) Save(search string, jobs map[linkdapi.JobID]Job) error {
+	return f.store.Save(search, jobs)
+}
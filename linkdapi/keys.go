@@ -0,0 +1,276 @@
+package linkdapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoKeys is returned when a KeyPool has no usable key left.
+var ErrNoKeys = errors.New("no usable API key")
+
+// CooldownError is returned by KeyPool.APIKey when every usable key is
+// cooling down after a 429. The client waits until Until, giving up when its
+// context is done, and asks for a key again.
+type CooldownError struct {
+	Until time.Time // When the first key becomes usable again
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("every API key is cooling down until %s", e.Until.Format(time.RFC3339))
+}
+
+// KeyProvider is a CredentialsProvider that also learns how requests made
+// with each key fared. Set Config.Keys to use one; NewClient uses the single
+// key it is given.
+//
+// Implementations must be safe for concurrent use.
+type KeyProvider interface {
+	CredentialsProvider
+
+	// Report records the outcome of a request made with key: the HTTP status
+	// code, or 0 if no response was received, and the response message, if
+	// any. It returns true if the request should be retried with another key.
+	Report(key string, status int, message string) bool
+}
+
+// staticKey is the KeyProvider for a single fixed key.
+type staticKey string
+
+func (k staticKey) APIKey() (string, error) { return string(k), nil }
+
+func (k staticKey) Report(string, int, string) bool { return false }
+
+// KeySelection is the order in which a KeyPool hands out keys.
+type KeySelection int
+
+const (
+	RoundRobin KeySelection = iota // Each usable key in turn
+	LeastUsed                      // The usable key with the fewest requests
+)
+
+// KeyPoolOptions configures a KeyPool.
+type KeyPoolOptions struct {
+	// Selection is the key selection strategy (default: RoundRobin)
+	Selection KeySelection
+
+	// Cooldown is how long a key that was throttled (429) is skipped (default: 1 minute)
+	Cooldown time.Duration
+
+	// Quota is the number of requests each key may make, 0 for unlimited (default: 0)
+	Quota int
+}
+
+// KeyStats reports the usage of one key in a KeyPool.
+type KeyStats struct {
+	Key           string    // The key, masked to its last 4 characters
+	Requests      int       // Requests made with the key
+	Successes     int       // Requests answered with a 2xx status
+	Failures      int       // Requests that failed with another status or no response
+	Throttled     int       // Requests answered with 429
+	LastUsed      time.Time // When the key was last handed out
+	CooldownUntil time.Time // When a throttled key becomes usable again
+	Removed       bool      // Whether the key was removed from rotation
+	Reason        string    // Why the key was removed, e.g. "unauthorized"
+}
+
+// KeyPool spreads requests across several API keys. Keys answered with 401
+// or reporting insufficient credits are removed from rotation, keys answered
+// with 429 cool down for a while, and requests are retried with the next key.
+//
+// Example:
+//
+//	pool := linkdapi.NewKeyPool([]string{"key_a", "key_b"}, &linkdapi.KeyPoolOptions{
+//	    Selection: linkdapi.LeastUsed,
+//	})
+//	config := linkdapi.DefaultConfig()
+//	config.Keys = pool
+//	client := linkdapi.NewClientWithConfig("", config)
+//
+// The KeyPool is safe for concurrent use by multiple goroutines.
+type KeyPool struct {
+	selection KeySelection
+	cooldown  time.Duration
+	quota     int
+
+	mu   sync.Mutex
+	keys []*poolKey
+	next int
+}
+
+type poolKey struct {
+	key   string
+	stats KeyStats
+}
+
+// NewKeyPool creates a pool of keys. Empty and duplicate keys are ignored.
+func NewKeyPool(keys []string, options *KeyPoolOptions) *KeyPool {
+	if options == nil {
+		options = &KeyPoolOptions{}
+	}
+	p := &KeyPool{
+		selection: options.Selection,
+		cooldown:  options.Cooldown,
+		quota:     options.Quota,
+	}
+	if p.cooldown <= 0 {
+		p.cooldown = time.Minute
+	}
+	for _, key := range keys {
+		p.Add(key)
+	}
+	return p
+}
+
+// Add adds a key to the pool, or returns a removed key to rotation.
+func (p *KeyPool) Add(key string) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if k := p.find(key); k != nil {
+		k.stats.Removed, k.stats.Reason = false, ""
+		return
+	}
+	p.keys = append(p.keys, &poolKey{key: key, stats: KeyStats{Key: maskKey(key)}})
+}
+
+// Remove takes a key out of rotation. Its stats are kept.
+func (p *KeyPool) Remove(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if k := p.find(key); k != nil {
+		k.stats.Removed, k.stats.Reason = true, "removed"
+	}
+}
+
+// APIKey returns the next usable key. If every key is cooling down, it
+// returns a *CooldownError naming when the first one becomes usable.
+func (p *KeyPool) APIKey() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	var chosen, coolest *poolKey
+	for i := range p.keys {
+		k := p.keys[(p.next+i)%len(p.keys)]
+		if k.stats.Removed || p.quota > 0 && k.stats.Requests >= p.quota {
+			continue
+		}
+		if now.Before(k.stats.CooldownUntil) {
+			if coolest == nil || k.stats.CooldownUntil.Before(coolest.stats.CooldownUntil) {
+				coolest = k
+			}
+			continue
+		}
+		if chosen == nil || p.selection == LeastUsed && k.stats.Requests < chosen.stats.Requests {
+			chosen = k
+		}
+		if p.selection == RoundRobin {
+			break
+		}
+	}
+	if chosen == nil && coolest != nil {
+		return "", &CooldownError{Until: coolest.stats.CooldownUntil}
+	}
+	if chosen == nil {
+		return "", ErrNoKeys
+	}
+
+	for i, k := range p.keys {
+		if k == chosen {
+			p.next = i + 1
+		}
+	}
+	chosen.stats.Requests++
+	chosen.stats.LastUsed = now
+	return chosen.key, nil
+}
+
+// Report records the outcome of a request made with key. It removes keys
+// answered with 401, 402 or an insufficient credits message and cools down
+// keys answered with 429, returning true if another key is usable.
+func (p *KeyPool) Report(key string, status int, message string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	k := p.find(key)
+	if k == nil {
+		return false
+	}
+
+	switch {
+	case status == http.StatusUnauthorized:
+		k.stats.Failures++
+		k.stats.Removed, k.stats.Reason = true, "unauthorized"
+	case status == http.StatusPaymentRequired || insufficientCredits(message):
+		k.stats.Failures++
+		k.stats.Removed, k.stats.Reason = true, "insufficient credits"
+	case status == http.StatusTooManyRequests:
+		k.stats.Throttled++
+		k.stats.CooldownUntil = time.Now().Add(p.cooldown)
+	case status >= 200 && status < 300:
+		k.stats.Successes++
+		return false
+	default:
+		k.stats.Failures++
+		return false
+	}
+	return p.usable() > 0
+}
+
+// Stats returns the usage of every key, in the order they were added.
+func (p *KeyPool) Stats() []KeyStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]KeyStats, len(p.keys))
+	for i, k := range p.keys {
+		stats[i] = k.stats
+	}
+	return stats
+}
+
+// usable counts the keys that are neither removed, out of quota nor cooling down.
+func (p *KeyPool) usable() int {
+	now := time.Now()
+	n := 0
+	for _, k := range p.keys {
+		if !k.stats.Removed && (p.quota == 0 || k.stats.Requests < p.quota) && !now.Before(k.stats.CooldownUntil) {
+			n++
+		}
+	}
+	return n
+}
+
+func (p *KeyPool) find(key string) *poolKey {
+	for _, k := range p.keys {
+		if k.key == key {
+			return k
+		}
+	}
+	return nil
+}
+
+// insufficientCredits reports whether an API message says the key is out of credits.
+func insufficientCredits(message string) bool {
+	message = strings.ToLower(message)
+	return strings.Contains(message, "insufficient credit") || strings.Contains(message, "out of credits") ||
+		strings.Contains(message, "no credits")
+}
+
+// maskKey hides all but the last 4 characters of a key.
+func maskKey(key string) string {
+	if len(key) <= 4 {
+		return strings.Repeat("*", len(key))
+	}
+	return strings.Repeat("*", len(key)-4) + key[len(key)-4:]
+}
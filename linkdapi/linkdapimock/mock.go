@@ -0,0 +1,718 @@
+// Code generated by internal/mockgen from linkdapi/api.go. DO NOT EDIT.
+
+package linkdapimock
+
+import (
+	"sync"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+)
+
+// Ensure that Client implements linkdapi.API.
+var _ linkdapi.API = (*Client)(nil)
+
+// Client is a mock implementation of linkdapi.API.
+//
+// Each method records its call, then delegates to the matching Func field if
+// set, and otherwise returns the next response scripted with On.
+type Client struct {
+	// GetProfileOverviewFunc mocks the GetProfileOverview method.
+	GetProfileOverviewFunc func(username string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetProfileDetailsFunc mocks the GetProfileDetails method.
+	GetProfileDetailsFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetContactInfoFunc mocks the GetContactInfo method.
+	GetContactInfoFunc func(username string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetFullExperienceFunc mocks the GetFullExperience method.
+	GetFullExperienceFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetCertificationsFunc mocks the GetCertifications method.
+	GetCertificationsFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetEducationFunc mocks the GetEducation method.
+	GetEducationFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetSkillsFunc mocks the GetSkills method.
+	GetSkillsFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetSocialMatrixFunc mocks the GetSocialMatrix method.
+	GetSocialMatrixFunc func(username string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetRecommendationsFunc mocks the GetRecommendations method.
+	GetRecommendationsFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetSimilarProfilesFunc mocks the GetSimilarProfiles method.
+	GetSimilarProfilesFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetProfileAboutFunc mocks the GetProfileAbout method.
+	GetProfileAboutFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetProfileReactionsFunc mocks the GetProfileReactions method.
+	GetProfileReactionsFunc func(urn string, cursor string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetProfileInterestsFunc mocks the GetProfileInterests method.
+	GetProfileInterestsFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetFullProfileFunc mocks the GetFullProfile method.
+	GetFullProfileFunc func(username string, urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetProfileServicesFunc mocks the GetProfileServices method.
+	GetProfileServicesFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetProfileURNFunc mocks the GetProfileURN method.
+	GetProfileURNFunc func(username string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// CompanyNameLookupFunc mocks the CompanyNameLookup method.
+	CompanyNameLookupFunc func(query string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetCompanyInfoFunc mocks the GetCompanyInfo method.
+	GetCompanyInfoFunc func(companyID string, name string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetSimilarCompaniesFunc mocks the GetSimilarCompanies method.
+	GetSimilarCompaniesFunc func(companyID string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetCompanyEmployeesDataFunc mocks the GetCompanyEmployeesData method.
+	GetCompanyEmployeesDataFunc func(companyID string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetCompanyJobsFunc mocks the GetCompanyJobs method.
+	GetCompanyJobsFunc func(companyIDs []string, start int, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetCompanyAffiliatedPagesFunc mocks the GetCompanyAffiliatedPages method.
+	GetCompanyAffiliatedPagesFunc func(companyID string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetCompanyPostsFunc mocks the GetCompanyPosts method.
+	GetCompanyPostsFunc func(companyID string, start int, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetCompanyIDFunc mocks the GetCompanyID method.
+	GetCompanyIDFunc func(universalName string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetCompanyDetailsV2Func mocks the GetCompanyDetailsV2 method.
+	GetCompanyDetailsV2Func func(companyID string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// SearchJobsFunc mocks the SearchJobs method.
+	SearchJobsFunc func(searchParams linkdapi.JobSearchParams, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetJobDetailsFunc mocks the GetJobDetails method.
+	GetJobDetailsFunc func(jobID string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetSimilarJobsFunc mocks the GetSimilarJobs method.
+	GetSimilarJobsFunc func(jobID string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetPeopleAlsoViewedJobsFunc mocks the GetPeopleAlsoViewedJobs method.
+	GetPeopleAlsoViewedJobsFunc func(jobID string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetJobDetailsV2Func mocks the GetJobDetailsV2 method.
+	GetJobDetailsV2Func func(jobID string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// SearchJobsV2Func mocks the SearchJobsV2 method.
+	SearchJobsV2Func func(searchParams linkdapi.JobSearchV2Params, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetHiringTeamFunc mocks the GetHiringTeam method.
+	GetHiringTeamFunc func(jobID string, start int, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetProfilePostedJobsFunc mocks the GetProfilePostedJobs method.
+	GetProfilePostedJobsFunc func(profileUrn string, start int, count int, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetFeaturedPostsFunc mocks the GetFeaturedPosts method.
+	GetFeaturedPostsFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetAllPostsFunc mocks the GetAllPosts method.
+	GetAllPostsFunc func(urn string, cursor string, start int, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetPostInfoFunc mocks the GetPostInfo method.
+	GetPostInfoFunc func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetPostCommentsFunc mocks the GetPostComments method.
+	GetPostCommentsFunc func(urn string, start int, count int, cursor string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetPostLikesFunc mocks the GetPostLikes method.
+	GetPostLikesFunc func(urn string, start int, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetAllCommentsFunc mocks the GetAllComments method.
+	GetAllCommentsFunc func(urn string, cursor string, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// GetCommentLikesFunc mocks the GetCommentLikes method.
+	GetCommentLikesFunc func(urns string, start int, opts ...linkdapi.RequestOption) (map[string]any, error)
+
+	// CloseFunc mocks the Close method.
+	CloseFunc func()
+
+	mu      sync.Mutex
+	calls   []Call
+	scripts map[string][]Result
+}
+
+// GetProfileOverview calls GetProfileOverviewFunc or returns the next scripted response.
+func (m *Client) GetProfileOverview(username string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetProfileOverview", []any{username, opts})
+	if m.GetProfileOverviewFunc != nil {
+		return m.GetProfileOverviewFunc(username, opts...)
+	}
+	return m.next("GetProfileOverview")
+}
+
+// GetProfileOverviewCalls returns the recorded calls to GetProfileOverview.
+func (m *Client) GetProfileOverviewCalls() []Call {
+	return m.CallsTo("GetProfileOverview")
+}
+
+// GetProfileDetails calls GetProfileDetailsFunc or returns the next scripted response.
+func (m *Client) GetProfileDetails(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetProfileDetails", []any{urn, opts})
+	if m.GetProfileDetailsFunc != nil {
+		return m.GetProfileDetailsFunc(urn, opts...)
+	}
+	return m.next("GetProfileDetails")
+}
+
+// GetProfileDetailsCalls returns the recorded calls to GetProfileDetails.
+func (m *Client) GetProfileDetailsCalls() []Call {
+	return m.CallsTo("GetProfileDetails")
+}
+
+// GetContactInfo calls GetContactInfoFunc or returns the next scripted response.
+func (m *Client) GetContactInfo(username string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetContactInfo", []any{username, opts})
+	if m.GetContactInfoFunc != nil {
+		return m.GetContactInfoFunc(username, opts...)
+	}
+	return m.next("GetContactInfo")
+}
+
+// GetContactInfoCalls returns the recorded calls to GetContactInfo.
+func (m *Client) GetContactInfoCalls() []Call {
+	return m.CallsTo("GetContactInfo")
+}
+
+// GetFullExperience calls GetFullExperienceFunc or returns the next scripted response.
+func (m *Client) GetFullExperience(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetFullExperience", []any{urn, opts})
+	if m.GetFullExperienceFunc != nil {
+		return m.GetFullExperienceFunc(urn, opts...)
+	}
+	return m.next("GetFullExperience")
+}
+
+// GetFullExperienceCalls returns the recorded calls to GetFullExperience.
+func (m *Client) GetFullExperienceCalls() []Call {
+	return m.CallsTo("GetFullExperience")
+}
+
+// GetCertifications calls GetCertificationsFunc or returns the next scripted response.
+func (m *Client) GetCertifications(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetCertifications", []any{urn, opts})
+	if m.GetCertificationsFunc != nil {
+		return m.GetCertificationsFunc(urn, opts...)
+	}
+	return m.next("GetCertifications")
+}
+
+// GetCertificationsCalls returns the recorded calls to GetCertifications.
+func (m *Client) GetCertificationsCalls() []Call {
+	return m.CallsTo("GetCertifications")
+}
+
+// GetEducation calls GetEducationFunc or returns the next scripted response.
+func (m *Client) GetEducation(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetEducation", []any{urn, opts})
+	if m.GetEducationFunc != nil {
+		return m.GetEducationFunc(urn, opts...)
+	}
+	return m.next("GetEducation")
+}
+
+// GetEducationCalls returns the recorded calls to GetEducation.
+func (m *Client) GetEducationCalls() []Call {
+	return m.CallsTo("GetEducation")
+}
+
+// GetSkills calls GetSkillsFunc or returns the next scripted response.
+func (m *Client) GetSkills(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetSkills", []any{urn, opts})
+	if m.GetSkillsFunc != nil {
+		return m.GetSkillsFunc(urn, opts...)
+	}
+	return m.next("GetSkills")
+}
+
+// GetSkillsCalls returns the recorded calls to GetSkills.
+func (m *Client) GetSkillsCalls() []Call {
+	return m.CallsTo("GetSkills")
+}
+
+// GetSocialMatrix calls GetSocialMatrixFunc or returns the next scripted response.
+func (m *Client) GetSocialMatrix(username string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetSocialMatrix", []any{username, opts})
+	if m.GetSocialMatrixFunc != nil {
+		return m.GetSocialMatrixFunc(username, opts...)
+	}
+	return m.next("GetSocialMatrix")
+}
+
+// GetSocialMatrixCalls returns the recorded calls to GetSocialMatrix.
+func (m *Client) GetSocialMatrixCalls() []Call {
+	return m.CallsTo("GetSocialMatrix")
+}
+
+// GetRecommendations calls GetRecommendationsFunc or returns the next scripted response.
+func (m *Client) GetRecommendations(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetRecommendations", []any{urn, opts})
+	if m.GetRecommendationsFunc != nil {
+		return m.GetRecommendationsFunc(urn, opts...)
+	}
+	return m.next("GetRecommendations")
+}
+
+// GetRecommendationsCalls returns the recorded calls to GetRecommendations.
+func (m *Client) GetRecommendationsCalls() []Call {
+	return m.CallsTo("GetRecommendations")
+}
+
+// GetSimilarProfiles calls GetSimilarProfilesFunc or returns the next scripted response.
+func (m *Client) GetSimilarProfiles(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetSimilarProfiles", []any{urn, opts})
+	if m.GetSimilarProfilesFunc != nil {
+		return m.GetSimilarProfilesFunc(urn, opts...)
+	}
+	return m.next("GetSimilarProfiles")
+}
+
+// GetSimilarProfilesCalls returns the recorded calls to GetSimilarProfiles.
+func (m *Client) GetSimilarProfilesCalls() []Call {
+	return m.CallsTo("GetSimilarProfiles")
+}
+
+// GetProfileAbout calls GetProfileAboutFunc or returns the next scripted response.
+func (m *Client) GetProfileAbout(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetProfileAbout", []any{urn, opts})
+	if m.GetProfileAboutFunc != nil {
+		return m.GetProfileAboutFunc(urn, opts...)
+	}
+	return m.next("GetProfileAbout")
+}
+
+// GetProfileAboutCalls returns the recorded calls to GetProfileAbout.
+func (m *Client) GetProfileAboutCalls() []Call {
+	return m.CallsTo("GetProfileAbout")
+}
+
+// GetProfileReactions calls GetProfileReactionsFunc or returns the next scripted response.
+func (m *Client) GetProfileReactions(urn string, cursor string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetProfileReactions", []any{urn, cursor, opts})
+	if m.GetProfileReactionsFunc != nil {
+		return m.GetProfileReactionsFunc(urn, cursor, opts...)
+	}
+	return m.next("GetProfileReactions")
+}
+
+// GetProfileReactionsCalls returns the recorded calls to GetProfileReactions.
+func (m *Client) GetProfileReactionsCalls() []Call {
+	return m.CallsTo("GetProfileReactions")
+}
+
+// GetProfileInterests calls GetProfileInterestsFunc or returns the next scripted response.
+func (m *Client) GetProfileInterests(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetProfileInterests", []any{urn, opts})
+	if m.GetProfileInterestsFunc != nil {
+		return m.GetProfileInterestsFunc(urn, opts...)
+	}
+	return m.next("GetProfileInterests")
+}
+
+// GetProfileInterestsCalls returns the recorded calls to GetProfileInterests.
+func (m *Client) GetProfileInterestsCalls() []Call {
+	return m.CallsTo("GetProfileInterests")
+}
+
+// GetFullProfile calls GetFullProfileFunc or returns the next scripted response.
+func (m *Client) GetFullProfile(username string, urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetFullProfile", []any{username, urn, opts})
+	if m.GetFullProfileFunc != nil {
+		return m.GetFullProfileFunc(username, urn, opts...)
+	}
+	return m.next("GetFullProfile")
+}
+
+// GetFullProfileCalls returns the recorded calls to GetFullProfile.
+func (m *Client) GetFullProfileCalls() []Call {
+	return m.CallsTo("GetFullProfile")
+}
+
+// GetProfileServices calls GetProfileServicesFunc or returns the next scripted response.
+func (m *Client) GetProfileServices(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetProfileServices", []any{urn, opts})
+	if m.GetProfileServicesFunc != nil {
+		return m.GetProfileServicesFunc(urn, opts...)
+	}
+	return m.next("GetProfileServices")
+}
+
+// GetProfileServicesCalls returns the recorded calls to GetProfileServices.
+func (m *Client) GetProfileServicesCalls() []Call {
+	return m.CallsTo("GetProfileServices")
+}
+
+// GetProfileURN calls GetProfileURNFunc or returns the next scripted response.
+func (m *Client) GetProfileURN(username string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetProfileURN", []any{username, opts})
+	if m.GetProfileURNFunc != nil {
+		return m.GetProfileURNFunc(username, opts...)
+	}
+	return m.next("GetProfileURN")
+}
+
+// GetProfileURNCalls returns the recorded calls to GetProfileURN.
+func (m *Client) GetProfileURNCalls() []Call {
+	return m.CallsTo("GetProfileURN")
+}
+
+// CompanyNameLookup calls CompanyNameLookupFunc or returns the next scripted response.
+func (m *Client) CompanyNameLookup(query string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("CompanyNameLookup", []any{query, opts})
+	if m.CompanyNameLookupFunc != nil {
+		return m.CompanyNameLookupFunc(query, opts...)
+	}
+	return m.next("CompanyNameLookup")
+}
+
+// CompanyNameLookupCalls returns the recorded calls to CompanyNameLookup.
+func (m *Client) CompanyNameLookupCalls() []Call {
+	return m.CallsTo("CompanyNameLookup")
+}
+
+// GetCompanyInfo calls GetCompanyInfoFunc or returns the next scripted response.
+func (m *Client) GetCompanyInfo(companyID string, name string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetCompanyInfo", []any{companyID, name, opts})
+	if m.GetCompanyInfoFunc != nil {
+		return m.GetCompanyInfoFunc(companyID, name, opts...)
+	}
+	return m.next("GetCompanyInfo")
+}
+
+// GetCompanyInfoCalls returns the recorded calls to GetCompanyInfo.
+func (m *Client) GetCompanyInfoCalls() []Call {
+	return m.CallsTo("GetCompanyInfo")
+}
+
+// GetSimilarCompanies calls GetSimilarCompaniesFunc or returns the next scripted response.
+func (m *Client) GetSimilarCompanies(companyID string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetSimilarCompanies", []any{companyID, opts})
+	if m.GetSimilarCompaniesFunc != nil {
+		return m.GetSimilarCompaniesFunc(companyID, opts...)
+	}
+	return m.next("GetSimilarCompanies")
+}
+
+// GetSimilarCompaniesCalls returns the recorded calls to GetSimilarCompanies.
+func (m *Client) GetSimilarCompaniesCalls() []Call {
+	return m.CallsTo("GetSimilarCompanies")
+}
+
+// GetCompanyEmployeesData calls GetCompanyEmployeesDataFunc or returns the next scripted response.
+func (m *Client) GetCompanyEmployeesData(companyID string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetCompanyEmployeesData", []any{companyID, opts})
+	if m.GetCompanyEmployeesDataFunc != nil {
+		return m.GetCompanyEmployeesDataFunc(companyID, opts...)
+	}
+	return m.next("GetCompanyEmployeesData")
+}
+
+// GetCompanyEmployeesDataCalls returns the recorded calls to GetCompanyEmployeesData.
+func (m *Client) GetCompanyEmployeesDataCalls() []Call {
+	return m.CallsTo("GetCompanyEmployeesData")
+}
+
+// GetCompanyJobs calls GetCompanyJobsFunc or returns the next scripted response.
+func (m *Client) GetCompanyJobs(companyIDs []string, start int, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetCompanyJobs", []any{companyIDs, start, opts})
+	if m.GetCompanyJobsFunc != nil {
+		return m.GetCompanyJobsFunc(companyIDs, start, opts...)
+	}
+	return m.next("GetCompanyJobs")
+}
+
+// GetCompanyJobsCalls returns the recorded calls to GetCompanyJobs.
+func (m *Client) GetCompanyJobsCalls() []Call {
+	return m.CallsTo("GetCompanyJobs")
+}
+
+// GetCompanyAffiliatedPages calls GetCompanyAffiliatedPagesFunc or returns the next scripted response.
+func (m *Client) GetCompanyAffiliatedPages(companyID string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetCompanyAffiliatedPages", []any{companyID, opts})
+	if m.GetCompanyAffiliatedPagesFunc != nil {
+		return m.GetCompanyAffiliatedPagesFunc(companyID, opts...)
+	}
+	return m.next("GetCompanyAffiliatedPages")
+}
+
+// GetCompanyAffiliatedPagesCalls returns the recorded calls to GetCompanyAffiliatedPages.
+func (m *Client) GetCompanyAffiliatedPagesCalls() []Call {
+	return m.CallsTo("GetCompanyAffiliatedPages")
+}
+
+// GetCompanyPosts calls GetCompanyPostsFunc or returns the next scripted response.
+func (m *Client) GetCompanyPosts(companyID string, start int, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetCompanyPosts", []any{companyID, start, opts})
+	if m.GetCompanyPostsFunc != nil {
+		return m.GetCompanyPostsFunc(companyID, start, opts...)
+	}
+	return m.next("GetCompanyPosts")
+}
+
+// GetCompanyPostsCalls returns the recorded calls to GetCompanyPosts.
+func (m *Client) GetCompanyPostsCalls() []Call {
+	return m.CallsTo("GetCompanyPosts")
+}
+
+// GetCompanyID calls GetCompanyIDFunc or returns the next scripted response.
+func (m *Client) GetCompanyID(universalName string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetCompanyID", []any{universalName, opts})
+	if m.GetCompanyIDFunc != nil {
+		return m.GetCompanyIDFunc(universalName, opts...)
+	}
+	return m.next("GetCompanyID")
+}
+
+// GetCompanyIDCalls returns the recorded calls to GetCompanyID.
+func (m *Client) GetCompanyIDCalls() []Call {
+	return m.CallsTo("GetCompanyID")
+}
+
+// GetCompanyDetailsV2 calls GetCompanyDetailsV2Func or returns the next scripted response.
+func (m *Client) GetCompanyDetailsV2(companyID string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetCompanyDetailsV2", []any{companyID, opts})
+	if m.GetCompanyDetailsV2Func != nil {
+		return m.GetCompanyDetailsV2Func(companyID, opts...)
+	}
+	return m.next("GetCompanyDetailsV2")
+}
+
+// GetCompanyDetailsV2Calls returns the recorded calls to GetCompanyDetailsV2.
+func (m *Client) GetCompanyDetailsV2Calls() []Call {
+	return m.CallsTo("GetCompanyDetailsV2")
+}
+
+// SearchJobs calls SearchJobsFunc or returns the next scripted response.
+func (m *Client) SearchJobs(searchParams linkdapi.JobSearchParams, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("SearchJobs", []any{searchParams, opts})
+	if m.SearchJobsFunc != nil {
+		return m.SearchJobsFunc(searchParams, opts...)
+	}
+	return m.next("SearchJobs")
+}
+
+// SearchJobsCalls returns the recorded calls to SearchJobs.
+func (m *Client) SearchJobsCalls() []Call {
+	return m.CallsTo("SearchJobs")
+}
+
+// GetJobDetails calls GetJobDetailsFunc or returns the next scripted response.
+func (m *Client) GetJobDetails(jobID string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetJobDetails", []any{jobID, opts})
+	if m.GetJobDetailsFunc != nil {
+		return m.GetJobDetailsFunc(jobID, opts...)
+	}
+	return m.next("GetJobDetails")
+}
+
+// GetJobDetailsCalls returns the recorded calls to GetJobDetails.
+func (m *Client) GetJobDetailsCalls() []Call {
+	return m.CallsTo("GetJobDetails")
+}
+
+// GetSimilarJobs calls GetSimilarJobsFunc or returns the next scripted response.
+func (m *Client) GetSimilarJobs(jobID string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetSimilarJobs", []any{jobID, opts})
+	if m.GetSimilarJobsFunc != nil {
+		return m.GetSimilarJobsFunc(jobID, opts...)
+	}
+	return m.next("GetSimilarJobs")
+}
+
+// GetSimilarJobsCalls returns the recorded calls to GetSimilarJobs.
+func (m *Client) GetSimilarJobsCalls() []Call {
+	return m.CallsTo("GetSimilarJobs")
+}
+
+// GetPeopleAlsoViewedJobs calls GetPeopleAlsoViewedJobsFunc or returns the next scripted response.
+func (m *Client) GetPeopleAlsoViewedJobs(jobID string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetPeopleAlsoViewedJobs", []any{jobID, opts})
+	if m.GetPeopleAlsoViewedJobsFunc != nil {
+		return m.GetPeopleAlsoViewedJobsFunc(jobID, opts...)
+	}
+	return m.next("GetPeopleAlsoViewedJobs")
+}
+
+// GetPeopleAlsoViewedJobsCalls returns the recorded calls to GetPeopleAlsoViewedJobs.
+func (m *Client) GetPeopleAlsoViewedJobsCalls() []Call {
+	return m.CallsTo("GetPeopleAlsoViewedJobs")
+}
+
+// GetJobDetailsV2 calls GetJobDetailsV2Func or returns the next scripted response.
+func (m *Client) GetJobDetailsV2(jobID string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetJobDetailsV2", []any{jobID, opts})
+	if m.GetJobDetailsV2Func != nil {
+		return m.GetJobDetailsV2Func(jobID, opts...)
+	}
+	return m.next("GetJobDetailsV2")
+}
+
+// GetJobDetailsV2Calls returns the recorded calls to GetJobDetailsV2.
+func (m *Client) GetJobDetailsV2Calls() []Call {
+	return m.CallsTo("GetJobDetailsV2")
+}
+
+// SearchJobsV2 calls SearchJobsV2Func or returns the next scripted response.
+func (m *Client) SearchJobsV2(searchParams linkdapi.JobSearchV2Params, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("SearchJobsV2", []any{searchParams, opts})
+	if m.SearchJobsV2Func != nil {
+		return m.SearchJobsV2Func(searchParams, opts...)
+	}
+	return m.next("SearchJobsV2")
+}
+
+// SearchJobsV2Calls returns the recorded calls to SearchJobsV2.
+func (m *Client) SearchJobsV2Calls() []Call {
+	return m.CallsTo("SearchJobsV2")
+}
+
+// GetHiringTeam calls GetHiringTeamFunc or returns the next scripted response.
+func (m *Client) GetHiringTeam(jobID string, start int, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetHiringTeam", []any{jobID, start, opts})
+	if m.GetHiringTeamFunc != nil {
+		return m.GetHiringTeamFunc(jobID, start, opts...)
+	}
+	return m.next("GetHiringTeam")
+}
+
+// GetHiringTeamCalls returns the recorded calls to GetHiringTeam.
+func (m *Client) GetHiringTeamCalls() []Call {
+	return m.CallsTo("GetHiringTeam")
+}
+
+// GetProfilePostedJobs calls GetProfilePostedJobsFunc or returns the next scripted response.
+func (m *Client) GetProfilePostedJobs(profileUrn string, start int, count int, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetProfilePostedJobs", []any{profileUrn, start, count, opts})
+	if m.GetProfilePostedJobsFunc != nil {
+		return m.GetProfilePostedJobsFunc(profileUrn, start, count, opts...)
+	}
+	return m.next("GetProfilePostedJobs")
+}
+
+// GetProfilePostedJobsCalls returns the recorded calls to GetProfilePostedJobs.
+func (m *Client) GetProfilePostedJobsCalls() []Call {
+	return m.CallsTo("GetProfilePostedJobs")
+}
+
+// GetFeaturedPosts calls GetFeaturedPostsFunc or returns the next scripted response.
+func (m *Client) GetFeaturedPosts(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetFeaturedPosts", []any{urn, opts})
+	if m.GetFeaturedPostsFunc != nil {
+		return m.GetFeaturedPostsFunc(urn, opts...)
+	}
+	return m.next("GetFeaturedPosts")
+}
+
+// GetFeaturedPostsCalls returns the recorded calls to GetFeaturedPosts.
+func (m *Client) GetFeaturedPostsCalls() []Call {
+	return m.CallsTo("GetFeaturedPosts")
+}
+
+// GetAllPosts calls GetAllPostsFunc or returns the next scripted response.
+func (m *Client) GetAllPosts(urn string, cursor string, start int, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetAllPosts", []any{urn, cursor, start, opts})
+	if m.GetAllPostsFunc != nil {
+		return m.GetAllPostsFunc(urn, cursor, start, opts...)
+	}
+	return m.next("GetAllPosts")
+}
+
+// GetAllPostsCalls returns the recorded calls to GetAllPosts.
+func (m *Client) GetAllPostsCalls() []Call {
+	return m.CallsTo("GetAllPosts")
+}
+
+// GetPostInfo calls GetPostInfoFunc or returns the next scripted response.
+func (m *Client) GetPostInfo(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetPostInfo", []any{urn, opts})
+	if m.GetPostInfoFunc != nil {
+		return m.GetPostInfoFunc(urn, opts...)
+	}
+	return m.next("GetPostInfo")
+}
+
+// GetPostInfoCalls returns the recorded calls to GetPostInfo.
+func (m *Client) GetPostInfoCalls() []Call {
+	return m.CallsTo("GetPostInfo")
+}
+
+// GetPostComments calls GetPostCommentsFunc or returns the next scripted response.
+func (m *Client) GetPostComments(urn string, start int, count int, cursor string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetPostComments", []any{urn, start, count, cursor, opts})
+	if m.GetPostCommentsFunc != nil {
+		return m.GetPostCommentsFunc(urn, start, count, cursor, opts...)
+	}
+	return m.next("GetPostComments")
+}
+
+// GetPostCommentsCalls returns the recorded calls to GetPostComments.
+func (m *Client) GetPostCommentsCalls() []Call {
+	return m.CallsTo("GetPostComments")
+}
+
+// GetPostLikes calls GetPostLikesFunc or returns the next scripted response.
+func (m *Client) GetPostLikes(urn string, start int, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetPostLikes", []any{urn, start, opts})
+	if m.GetPostLikesFunc != nil {
+		return m.GetPostLikesFunc(urn, start, opts...)
+	}
+	return m.next("GetPostLikes")
+}
+
+// GetPostLikesCalls returns the recorded calls to GetPostLikes.
+func (m *Client) GetPostLikesCalls() []Call {
+	return m.CallsTo("GetPostLikes")
+}
+
+// GetAllComments calls GetAllCommentsFunc or returns the next scripted response.
+func (m *Client) GetAllComments(urn string, cursor string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetAllComments", []any{urn, cursor, opts})
+	if m.GetAllCommentsFunc != nil {
+		return m.GetAllCommentsFunc(urn, cursor, opts...)
+	}
+	return m.next("GetAllComments")
+}
+
+// GetAllCommentsCalls returns the recorded calls to GetAllComments.
+func (m *Client) GetAllCommentsCalls() []Call {
+	return m.CallsTo("GetAllComments")
+}
+
+// GetCommentLikes calls GetCommentLikesFunc or returns the next scripted response.
+func (m *Client) GetCommentLikes(urns string, start int, opts ...linkdapi.RequestOption) (map[string]any, error) {
+	m.record("GetCommentLikes", []any{urns, start, opts})
+	if m.GetCommentLikesFunc != nil {
+		return m.GetCommentLikesFunc(urns, start, opts...)
+	}
+	return m.next("GetCommentLikes")
+}
+
+// GetCommentLikesCalls returns the recorded calls to GetCommentLikes.
+func (m *Client) GetCommentLikesCalls() []Call {
+	return m.CallsTo("GetCommentLikes")
+}
+
+// Close calls CloseFunc or returns the next scripted response.
+func (m *Client) Close() {
+	m.record("Close", nil)
+	if m.CloseFunc != nil {
+		m.CloseFunc()
+	}
+}
+
+// CloseCalls returns the recorded calls to Close.
+func (m *Client) CloseCalls() []Call {
+	return m.CallsTo("Close")
+}
@@ -0,0 +1,98 @@
+// Package linkdapimock provides a mock implementation of linkdapi.API for
+// testing code that depends on the LinkdAPI client.
+//
+// Script responses per method, or set a Func field for full control:
+//
+//	mock := &linkdapimock.Client{}
+//	mock.On("GetProfileOverview",
+//	    linkdapimock.Result{Response: map[string]any{"success": true}},
+//	)
+//	mock.GetSkillsFunc = func(urn string, opts ...linkdapi.RequestOption) (map[string]any, error) {
+//	    return nil, errors.New("boom")
+//	}
+//
+//	svc := NewEnricher(mock) // accepts linkdapi.ProfileAPI
+//	// ...
+//	calls := mock.GetProfileOverviewCalls()
+package linkdapimock
+
+//go:generate go run ../../internal/mockgen -src ../api.go -out mock.go
+
+import "fmt"
+
+// Call is a recorded method call.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Result is a scripted method result.
+type Result struct {
+	Response map[string]any
+	Err      error
+}
+
+// On queues results for method, which are returned by successive calls in
+// order. Once the queue is down to its last result, that result is returned
+// for every further call.
+func (m *Client) On(method string, results ...Result) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.scripts == nil {
+		m.scripts = make(map[string][]Result)
+	}
+	m.scripts[method] = append(m.scripts[method], results...)
+}
+
+// Calls returns every recorded call, in call order.
+func (m *Client) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the recorded calls to method.
+func (m *Client) CallsTo(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var out []Call
+	for _, c := range m.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Reset clears recorded calls and scripted results. Func fields are kept.
+func (m *Client) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = nil
+	m.scripts = nil
+}
+
+func (m *Client) record(method string, args []any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+func (m *Client) next(method string) (map[string]any, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	queue := m.scripts[method]
+	if len(queue) == 0 {
+		return nil, fmt.Errorf("linkdapimock: no response scripted for %s", method)
+	}
+	if len(queue) > 1 {
+		m.scripts[method] = queue[1:]
+	}
+	return queue[0].Response, queue[0].Err
+}
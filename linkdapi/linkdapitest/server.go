@@ -0,0 +1,360 @@
+// Package linkdapitest provides an in-process fake of the LinkdAPI service
+// for testing code that uses the linkdapi package without network access.
+//
+// Basic usage:
+//
+//	srv := linkdapitest.NewServer("test_key")
+//	defer srv.Close()
+//
+//	srv.Fixture("api/v1/profile/overview", map[string]string{"username": "ryanroslansky"},
+//	    map[string]any{"fullName": "Ryan Roslansky"})
+//
+//	client := srv.NewClient(nil)
+//	defer client.Close()
+//
+//	profile, err := client.GetProfileOverview("ryanroslansky")
+package linkdapitest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+)
+
+// APIKeyHeader is the header the client uses to authenticate requests.
+const APIKeyHeader = "X-linkdapi-apikey"
+
+// Endpoints lists every endpoint path called by linkdapi.Client.
+var Endpoints = []string{
+	"api/v1/profile/overview",
+	"api/v1/profile/details",
+	"api/v1/profile/contact-info",
+	"api/v1/profile/full-experience",
+	"api/v1/profile/certifications",
+	"api/v1/profile/education",
+	"api/v1/profile/skills",
+	"api/v1/profile/social-matrix",
+	"api/v1/profile/recommendations",
+	"api/v1/profile/similar",
+	"api/v1/profile/about",
+	"api/v1/profile/reactions",
+	"api/v1/profile/interests",
+	"api/v1/profile/full",
+	"api/v1/profile/services",
+	"api/v1/profile/username-to-urn",
+	"api/v1/jobs/posted-by-profile",
+	"api/v1/companies/name-lookup",
+	"api/v1/companies/company/info",
+	"api/v1/companies/company/similar",
+	"api/v1/companies/company/employees-data",
+	"api/v1/companies/jobs",
+	"api/v1/companies/company/affiliated-pages",
+	"api/v1/companies/company/posts",
+	"api/v1/companies/company/universal-name-to-id",
+	"api/v1/companies/company/info-v2",
+	"api/v1/jobs/search",
+	"api/v1/jobs/job/details",
+	"api/v1/jobs/job/similar",
+	"api/v1/jobs/job/people-also-viewed",
+	"api/v1/jobs/job/details-v2",
+	"api/v1/search/jobs",
+	"api/v1/jobs/job/hiring-team",
+	"api/v1/posts/featured",
+	"api/v1/posts/all",
+	"api/v1/posts/info",
+	"api/v1/posts/comments",
+	"api/v1/posts/likes",
+	"api/v1/comments/all",
+	"api/v1/comments/likes",
+}
+
+// Response describes how the server answers a matched request.
+type Response struct {
+	// Status is the HTTP status code (default: 200)
+	Status int
+
+	// Success, Message and Data populate the JSON envelope returned by the API:
+	// {"success": ..., "statusCode": ..., "message": ..., "errors": null, "data": ...}
+	Success bool
+	Message string
+	Data    any
+
+	// Raw, if set, is written verbatim instead of the JSON envelope.
+	Raw string
+
+	// Delay is how long the server waits before responding.
+	Delay time.Duration
+}
+
+// OK returns a successful response carrying data.
+func OK(data any) Response {
+	return Response{Success: true, Message: "Data retrieved successfully", Data: data}
+}
+
+// Unsuccessful returns a 200 response whose envelope has success set to false,
+// as the API does when a resource cannot be found.
+func Unsuccessful(message string) Response {
+	return Response{Success: false, Message: message}
+}
+
+// Error returns a response with the given HTTP status and an unsuccessful envelope.
+func Error(status int, message string) Response {
+	return Response{Status: status, Success: false, Message: message}
+}
+
+// Request is a request received by the server.
+type Request struct {
+	Method   string
+	Endpoint string // Path without the leading slash, e.g. "api/v1/profile/overview"
+	Query    url.Values
+	APIKey   string
+	Header   http.Header
+}
+
+type fixture struct {
+	params   map[string]string
+	response Response
+}
+
+type fault struct {
+	response  Response
+	remaining int
+}
+
+// Server is an in-process fake LinkdAPI server backed by httptest.Server.
+//
+// Requests to known endpoints are answered from registered fixtures. When no
+// fixture matches, the server answers with a successful envelope and empty data.
+// Unknown paths get a 404 and requests without a valid API key get a 401.
+//
+// The Server is safe for concurrent use by multiple goroutines.
+type Server struct {
+	*httptest.Server
+
+	// APIKey is the key expected in the X-linkdapi-apikey header.
+	// If empty, any non-empty key is accepted.
+	APIKey string
+
+	mu        sync.Mutex
+	endpoints map[string]bool
+	fixtures  map[string][]fixture
+	faults    map[string][]*fault
+	latency   time.Duration
+	requests  []Request
+}
+
+// NewServer starts a fake LinkdAPI server that accepts apiKey.
+// The caller must call Close when finished.
+func NewServer(apiKey string) *Server {
+	s := &Server{
+		APIKey:    apiKey,
+		endpoints: make(map[string]bool, len(Endpoints)),
+		fixtures:  make(map[string][]fixture),
+		faults:    make(map[string][]*fault),
+	}
+	for _, endpoint := range Endpoints {
+		s.endpoints[endpoint] = true
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient returns a linkdapi.Client that talks to the server using the
+// server's API key. The config's BaseURL is overridden; a nil config uses
+// linkdapi.DefaultConfig with retries disabled.
+func (s *Server) NewClient(config *linkdapi.Config) *linkdapi.Client {
+	if config == nil {
+		config = linkdapi.DefaultConfig()
+		config.MaxRetries = 0
+	} else {
+		c := *config
+		config = &c
+	}
+	config.BaseURL = s.URL
+
+	apiKey := s.APIKey
+	if apiKey == "" {
+		apiKey = "linkdapitest"
+	}
+	return linkdapi.NewClientWithConfig(apiKey, config)
+}
+
+// Fixture registers a successful response carrying data for requests to
+// endpoint whose query contains every key/value in params.
+func (s *Server) Fixture(endpoint string, params map[string]string, data any) {
+	s.Respond(endpoint, params, OK(data))
+}
+
+// Respond registers a response for requests to endpoint whose query contains
+// every key/value in params. A nil params map matches every request.
+// When several fixtures match, the one with the most params wins and,
+// among those, the most recently registered.
+func (s *Server) Respond(endpoint string, params map[string]string, response Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	endpoint = normalize(endpoint)
+	s.fixtures[endpoint] = append(s.fixtures[endpoint], fixture{params: params, response: response})
+}
+
+// FailNext makes the next n authorized requests to endpoint return response
+// before any fixture is consulted. Use it to inject errors, latency or
+// unsuccessful envelopes into otherwise healthy endpoints. Requests rejected
+// with a 401, 404 or 405 do not count, and n <= 0 injects nothing.
+func (s *Server) FailNext(endpoint string, n int, response Response) {
+	if n <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	endpoint = normalize(endpoint)
+	s.faults[endpoint] = append(s.faults[endpoint], &fault{response: response, remaining: n})
+}
+
+// SetLatency adds a delay to every response.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latency = d
+}
+
+// Requests returns the requests received so far, in arrival order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// RequestsTo returns the requests received for endpoint.
+func (s *Server) RequestsTo(endpoint string) []Request {
+	endpoint = normalize(endpoint)
+
+	var out []Request
+	for _, r := range s.Requests() {
+		if r.Endpoint == endpoint {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// Reset removes all fixtures, faults and recorded requests.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fixtures = make(map[string][]fixture)
+	s.faults = make(map[string][]*fault)
+	s.requests = nil
+	s.latency = 0
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint := normalize(r.URL.Path)
+	query := r.URL.Query()
+	apiKey := r.Header.Get(APIKeyHeader)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method:   r.Method,
+		Endpoint: endpoint,
+		Query:    query,
+		APIKey:   apiKey,
+		Header:   r.Header.Clone(),
+	})
+	latency := s.latency
+
+	// Rejected requests must not consume faults meant for valid ones
+	var response Response
+	switch {
+	case !s.endpoints[endpoint]:
+		response = Error(http.StatusNotFound, "Endpoint not found")
+	case r.Method != http.MethodGet:
+		response = Error(http.StatusMethodNotAllowed, "Method not allowed")
+	case apiKey == "" || (s.APIKey != "" && apiKey != s.APIKey):
+		response = Error(http.StatusUnauthorized, "Invalid API key")
+	default:
+		response = s.lookup(endpoint, query)
+	}
+	s.mu.Unlock()
+
+	if delay := latency + response.Delay; delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	writeResponse(w, response)
+}
+
+// lookup finds the response for an authorized request to a known endpoint.
+// It must be called with s.mu held.
+func (s *Server) lookup(endpoint string, query url.Values) Response {
+	if faults := s.faults[endpoint]; len(faults) > 0 {
+		f := faults[0]
+		f.remaining--
+		if f.remaining <= 0 {
+			s.faults[endpoint] = faults[1:]
+		}
+		return f.response
+	}
+
+	best, bestLen := -1, -1
+	fixtures := s.fixtures[endpoint]
+	for i, f := range fixtures {
+		if matches(f.params, query) && len(f.params) >= bestLen {
+			best, bestLen = i, len(f.params)
+		}
+	}
+	if best < 0 {
+		return OK(map[string]any{})
+	}
+	return fixtures[best].response
+}
+
+func matches(params map[string]string, query url.Values) bool {
+	for key, value := range params {
+		if query.Get(key) != value {
+			return false
+		}
+	}
+	return true
+}
+
+func writeResponse(w http.ResponseWriter, response Response) {
+	status := response.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	if response.Raw != "" {
+		w.WriteHeader(status)
+		w.Write([]byte(response.Raw))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"success":    response.Success,
+		"statusCode": status,
+		"message":    response.Message,
+		"errors":     nil,
+		"data":       response.Data,
+	})
+}
+
+func normalize(endpoint string) string {
+	return strings.Trim(endpoint, "/")
+}
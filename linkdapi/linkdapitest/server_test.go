@@ -0,0 +1,182 @@
+package linkdapitest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+)
+
+const overview = "api/v1/profile/overview"
+
+// get sends a request to the server with apiKey and returns the status and
+// decoded envelope.
+func get(t *testing.T, s *Server, method, endpoint, apiKey string) (int, map[string]any) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, s.URL+"/"+endpoint+"?username=ryanroslansky", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if apiKey != "" {
+		req.Header.Set(APIKeyHeader, apiKey)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var body map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding %s response: %v", endpoint, err)
+	}
+	return resp.StatusCode, body
+}
+
+func TestServerAuth(t *testing.T) {
+	s := NewServer("test_key")
+	defer s.Close()
+
+	tests := []struct {
+		name       string
+		method     string
+		endpoint   string
+		apiKey     string
+		wantStatus int
+	}{
+		{"valid", http.MethodGet, overview, "test_key", http.StatusOK},
+		{"missing key", http.MethodGet, overview, "", http.StatusUnauthorized},
+		{"wrong key", http.MethodGet, overview, "other_key", http.StatusUnauthorized},
+		{"wrong method", http.MethodPost, overview, "test_key", http.StatusMethodNotAllowed},
+		{"unknown endpoint", http.MethodGet, "api/v1/nope", "test_key", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := get(t, s, tt.method, tt.endpoint, tt.apiKey)
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+			if success, _ := body["success"].(bool); success != (tt.wantStatus == http.StatusOK) {
+				t.Errorf("success = %v for status %d", success, status)
+			}
+		})
+	}
+
+	t.Run("any key", func(t *testing.T) {
+		open := NewServer("")
+		defer open.Close()
+		if status, _ := get(t, open, http.MethodGet, overview, "anything"); status != http.StatusOK {
+			t.Errorf("status = %d, want 200", status)
+		}
+		if status, _ := get(t, open, http.MethodGet, overview, ""); status != http.StatusUnauthorized {
+			t.Errorf("status without key = %d, want 401", status)
+		}
+	})
+}
+
+func TestServerFailNext(t *testing.T) {
+	s := NewServer("test_key")
+	defer s.Close()
+	s.Fixture(overview, nil, map[string]any{"fullName": "Ryan Roslansky"})
+	s.FailNext(overview, 2, Error(http.StatusInternalServerError, "boom"))
+
+	// Rejected requests leave the faults for valid ones
+	if status, _ := get(t, s, http.MethodGet, overview, "bad_key"); status != http.StatusUnauthorized {
+		t.Fatalf("unauthorized status = %d, want 401", status)
+	}
+	if status, _ := get(t, s, http.MethodPost, overview, "test_key"); status != http.StatusMethodNotAllowed {
+		t.Fatalf("wrong method status = %d, want 405", status)
+	}
+
+	for i, want := range []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK} {
+		status, body := get(t, s, http.MethodGet, overview, "test_key")
+		if status != want {
+			t.Errorf("request %d: status = %d, want %d", i, status, want)
+		}
+		if want == http.StatusOK {
+			data, _ := body["data"].(map[string]any)
+			if data["fullName"] != "Ryan Roslansky" {
+				t.Errorf("request %d: data = %v, want the fixture", i, body["data"])
+			}
+		}
+	}
+
+	if got := len(s.RequestsTo(overview)); got != 5 {
+		t.Errorf("recorded %d requests, want 5", got)
+	}
+}
+
+func TestServerFailNextNonPositive(t *testing.T) {
+	s := NewServer("test_key")
+	defer s.Close()
+
+	s.FailNext(overview, 0, Error(http.StatusInternalServerError, "boom"))
+	s.FailNext(overview, -1, Error(http.StatusInternalServerError, "boom"))
+	if status, _ := get(t, s, http.MethodGet, overview, "test_key"); status != http.StatusOK {
+		t.Errorf("status = %d, want 200 with no faults injected", status)
+	}
+}
+
+func TestServerFixtures(t *testing.T) {
+	s := NewServer("test_key")
+	defer s.Close()
+	s.Fixture(overview, nil, map[string]any{"fullName": "Anyone"})
+	s.Fixture(overview, map[string]string{"username": "ryanroslansky"}, map[string]any{"fullName": "Ryan Roslansky"})
+	s.Respond(overview, map[string]string{"username": "nobody"}, Unsuccessful("Profile not found"))
+
+	client := s.NewClient(nil)
+	defer client.Close()
+
+	tests := []struct {
+		username string
+		want     string
+	}{
+		{"ryanroslansky", "Ryan Roslansky"},
+		{"someone", "Anyone"},
+	}
+	for _, tt := range tests {
+		resp, err := client.GetProfileOverview(tt.username)
+		if err != nil {
+			t.Fatalf("GetProfileOverview(%q): %v", tt.username, err)
+		}
+		data, _ := resp["data"].(map[string]any)
+		if data["fullName"] != tt.want {
+			t.Errorf("GetProfileOverview(%q) fullName = %v, want %q", tt.username, data["fullName"], tt.want)
+		}
+	}
+
+	resp, err := client.GetProfileOverview("nobody")
+	if err != nil {
+		t.Fatalf("GetProfileOverview(nobody): %v", err)
+	}
+	if success, _ := resp["success"].(bool); success || resp["message"] != "Profile not found" {
+		t.Errorf("GetProfileOverview(nobody) = %v, want an unsuccessful envelope", resp)
+	}
+}
+
+func TestServerClientErrors(t *testing.T) {
+	s := NewServer("test_key")
+	defer s.Close()
+	s.FailNext(overview, 1, Error(http.StatusInternalServerError, "boom"))
+
+	client := s.NewClient(nil)
+	defer client.Close()
+
+	_, err := client.GetProfileOverview("ryanroslansky")
+	if err == nil || !strings.Contains(err.Error(), "500") {
+		t.Errorf("err = %v, want a 500 error", err)
+	}
+	if _, err := client.GetProfileOverview("ryanroslansky"); err != nil {
+		t.Errorf("after fault: %v", err)
+	}
+
+	wrong := linkdapi.NewClientWithConfig("wrong_key", &linkdapi.Config{BaseURL: s.URL})
+	defer wrong.Close()
+	if _, err := wrong.GetProfileOverview("ryanroslansky"); err == nil || errors.Is(err, linkdapi.ErrUnsuccessful) {
+		t.Errorf("wrong key err = %v, want a 401 error", err)
+	}
+}
@@ -0,0 +1,153 @@
+package linkdapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RequestOption customizes a single endpoint call. Every Client method
+// accepts options after its positional arguments:
+//
+//	comments, err := client.GetPostComments(urn, 0, 0, "",
+//	    linkdapi.WithCount(20),
+//	    linkdapi.WithTimeout(10*time.Second),
+//	)
+//
+// Pagination options override the corresponding positional argument or
+// params field. Passing one to an endpoint that does not paginate that way
+// fails with ErrInvalidParams before a request is sent.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	start   *int
+	count   *int
+	cursor  *string
+	timeout time.Duration
+	noCache bool
+	header  http.Header
+	ctx     context.Context
+
+	// deadline is set from timeout when the call starts and is shared with
+	// the identifier lookups made on its behalf.
+	deadline time.Time
+}
+
+// WithStart sets the pagination offset. Zero leaves the API default.
+func WithStart(start int) RequestOption {
+	return func(o *requestOptions) { o.start = &start }
+}
+
+// WithCount sets the number of results per page. Zero leaves the API default.
+func WithCount(count int) RequestOption {
+	return func(o *requestOptions) { o.count = &count }
+}
+
+// WithCursor sets the pagination cursor returned by a previous page.
+func WithCursor(cursor string) RequestOption {
+	return func(o *requestOptions) { o.cursor = &cursor }
+}
+
+// WithTimeout bounds the call, including retries and identifier lookups,
+// on top of the client's context and timeout. The deadline is fixed when the
+// method is called, so lookups and retries share one budget of d.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(o *requestOptions) { o.timeout = d }
+}
+
+// WithContext bounds the call, including retries and identifier lookups, by
+// ctx on top of the client's context, so canceling ctx aborts requests in
+// flight.
+func WithContext(ctx context.Context) RequestOption {
+	return func(o *requestOptions) { o.ctx = ctx }
+}
+
+// WithNoCache bypasses the resolver cache, forcing usernames and company
+// names to be looked up again, and asks intermediaries not to serve a cached
+// response.
+func WithNoCache() RequestOption {
+	return func(o *requestOptions) { o.noCache = true }
+}
+
+// WithHeader sets an additional request header. It may be given more than once.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.header == nil {
+			o.header = make(http.Header)
+		}
+		o.header.Set(key, value)
+	}
+}
+
+// Pagination options an endpoint accepts.
+const (
+	acceptsStart = 1 << iota
+	acceptsCount
+	acceptsCursor
+)
+
+// newRequestOptions applies opts and rejects pagination options the
+// endpoint does not accept.
+func newRequestOptions(opts []RequestOption, accepts int) (*requestOptions, error) {
+	o := &requestOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+
+	var v validator
+	if o.start != nil && accepts&acceptsStart == 0 {
+		v.add("WithStart", strconv.Itoa(*o.start), "is not supported by this endpoint")
+	}
+	if o.count != nil && accepts&acceptsCount == 0 {
+		v.add("WithCount", strconv.Itoa(*o.count), "is not supported by this endpoint")
+	}
+	if o.cursor != nil && accepts&acceptsCursor == 0 {
+		v.add("WithCursor", *o.cursor, "is not supported by this endpoint")
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	if o.timeout > 0 {
+		o.deadline = time.Now().Add(o.timeout)
+	}
+	return o, nil
+}
+
+// startOr returns the WithStart value if given, or start.
+func (o *requestOptions) startOr(start int) int {
+	if o.start != nil {
+		return *o.start
+	}
+	return start
+}
+
+// countOr returns the WithCount value if given, or count.
+func (o *requestOptions) countOr(count int) int {
+	if o.count != nil {
+		return *o.count
+	}
+	return count
+}
+
+// cursorOr returns the WithCursor value if given, or cursor.
+func (o *requestOptions) cursorOr(cursor string) string {
+	if o.cursor != nil {
+		return *o.cursor
+	}
+	return cursor
+}
+
+// lookup returns the options that carry over to identifier lookups and
+// section calls made on behalf of a call: context, deadline, headers and
+// cache bypass.
+func (o *requestOptions) lookup() []RequestOption {
+	if o == nil {
+		return nil
+	}
+	return []RequestOption{func(l *requestOptions) {
+		l.ctx, l.deadline, l.noCache, l.header = o.ctx, o.deadline, o.noCache, o.header
+	}}
+}
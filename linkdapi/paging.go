@@ -0,0 +1,84 @@
+package linkdapi
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Int returns a pointer to v, for setting optional numeric params:
+//
+//	linkdapi.JobSearchV2Params{Keyword: "golang", Count: linkdapi.Int(50)}
+func Int(v int) *int {
+	return &v
+}
+
+// pageLimits holds an endpoint's documented count default and bound.
+type pageLimits struct {
+	defaultCount int // Count the API uses when none is sent, 0 if undocumented
+	maxCount     int // Largest count the API accepts
+}
+
+// Pagination limits per endpoint. Where the API documents no bound, counts
+// are capped at 50 like the search endpoints.
+var (
+	postedJobsLimits    = pageLimits{maxCount: 50}
+	postCommentsLimits  = pageLimits{maxCount: 50}
+	jobSearchV2Limits   = pageLimits{defaultCount: 25, maxCount: 50}
+	peopleSearchLimits  = pageLimits{defaultCount: 20, maxCount: 50}
+	companySearchLimits = pageLimits{defaultCount: 25, maxCount: 50}
+	serviceSearchLimits = pageLimits{defaultCount: 25, maxCount: 50}
+)
+
+// checkStart reports a negative start offset.
+func checkStart(v *validator, field string, start *int) {
+	if start != nil && *start < 0 {
+		v.add(field, strconv.Itoa(*start), "must not be negative")
+	}
+}
+
+// checkCount reports a count outside 1 to limits.maxCount.
+func checkCount(v *validator, field string, count *int, limits pageLimits) {
+	if count == nil || *count >= 1 && *count <= limits.maxCount {
+		return
+	}
+	message := fmt.Sprintf("must be between 1 and %d", limits.maxCount)
+	if limits.defaultCount > 0 {
+		message += fmt.Sprintf(" (default %d)", limits.defaultCount)
+	}
+	v.add(field, strconv.Itoa(*count), message)
+}
+
+// unlessZero returns nil for zero and a pointer to v otherwise, so zero
+// positional arguments leave their parameter unset.
+func unlessZero(v int) *int {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+// startParam validates a positional start argument and adds it to params
+// unless it is zero, in which case the API default applies.
+func startParam(params map[string]string, start int) error {
+	var v validator
+	checkStart(&v, "start", unlessZero(start))
+	if err := v.err(); err != nil {
+		return err
+	}
+	intParam(params, "start", unlessZero(start))
+	return nil
+}
+
+// pageParams validates positional start and count arguments against limits
+// and adds those that are not zero to params.
+func pageParams(params map[string]string, start, count int, limits pageLimits) error {
+	var v validator
+	checkStart(&v, "start", unlessZero(start))
+	checkCount(&v, "count", unlessZero(count), limits)
+	if err := v.err(); err != nil {
+		return err
+	}
+	intParam(params, "start", unlessZero(start))
+	intParam(params, "count", unlessZero(count))
+	return nil
+}
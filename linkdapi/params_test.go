@@ -0,0 +1,240 @@
+package linkdapi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapitest"
+)
+
+func TestSearchJobsQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		params linkdapi.JobSearchParams
+		want   map[string]string
+	}{
+		{
+			name:   "keyword only",
+			params: linkdapi.JobSearchParams{Keyword: "Software Engineer", Start: linkdapi.Int(25)},
+			want:   map[string]string{"keyword": "Software Engineer", "start": "25"},
+		},
+		{
+			name: "all fields",
+			params: linkdapi.JobSearchParams{
+				Keyword:         "Software Engineer",
+				Location:        "San Francisco, CA",
+				GeoID:           "90000084",
+				CompanyIDs:      []string{"1441", "1035"},
+				JobTypes:        []linkdapi.JobType{linkdapi.JobTypeFullTime, linkdapi.JobTypeContract},
+				Experience:      []linkdapi.ExperienceLevel{linkdapi.ExperienceMidSenior, linkdapi.ExperienceDirector},
+				Regions:         []string{"us", "ca"},
+				TimePosted:      linkdapi.TimePostedWeek,
+				Salary:          linkdapi.Salary100k,
+				WorkArrangement: []linkdapi.WorkArrangement{linkdapi.WorkRemote, linkdapi.WorkHybrid},
+				Start:           linkdapi.Int(50),
+			},
+			want: map[string]string{
+				"keyword":         "Software Engineer",
+				"location":        "San Francisco, CA",
+				"geoId":           "90000084",
+				"companyIds":      "1441,1035",
+				"jobTypes":        "full_time,contract",
+				"experience":      "mid_senior,director",
+				"regions":         "us,ca",
+				"timePosted":      "1week",
+				"salary":          "100k",
+				"workArrangement": "remote,hybrid",
+				"start":           "50",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := linkdapitest.NewServer("test_key")
+			defer srv.Close()
+
+			client := srv.NewClient(nil)
+			defer client.Close()
+
+			if _, err := client.SearchJobs(tt.params); err != nil {
+				t.Fatalf("SearchJobs: %v", err)
+			}
+			reqs := srv.RequestsTo("api/v1/jobs/search")
+			if len(reqs) != 1 {
+				t.Fatalf("got %d requests, want 1", len(reqs))
+			}
+			assertQuery(t, reqs[0].Query, tt.want)
+		})
+	}
+}
+
+func TestSearchJobsV2Query(t *testing.T) {
+	yes, no := true, false
+
+	tests := []struct {
+		name   string
+		params linkdapi.JobSearchV2Params
+		want   map[string]string
+	}{
+		{
+			name:   "count omitted when unset",
+			params: linkdapi.JobSearchV2Params{Keyword: "golang", Start: linkdapi.Int(25)},
+			want:   map[string]string{"keyword": "golang", "start": "25"},
+		},
+		{
+			name: "all fields",
+			params: linkdapi.JobSearchV2Params{
+				Keyword:           "Software Engineer",
+				Start:             linkdapi.Int(25),
+				Count:             linkdapi.Int(50),
+				SortBy:            linkdapi.SortByDatePosted,
+				DatePosted:        linkdapi.TimePosted24h,
+				Experience:        []linkdapi.ExperienceLevel{linkdapi.ExperienceEntryLevel, linkdapi.ExperienceExecutive},
+				JobTypes:          []linkdapi.JobType{linkdapi.JobTypeFullTime, linkdapi.JobTypeOther},
+				WorkplaceTypes:    []linkdapi.WorkArrangement{linkdapi.WorkRemote},
+				Salary:            linkdapi.Salary90k,
+				Companies:         []string{"1441"},
+				Industries:        []string{"4", "6"},
+				Locations:         []string{"103644278"},
+				Functions:         []string{"it", "eng"},
+				Titles:            []string{"9"},
+				Benefits:          []linkdapi.Benefit{linkdapi.BenefitMedicalInsurance, linkdapi.Benefit401k},
+				Commitments:       []linkdapi.Commitment{linkdapi.CommitmentDEI, linkdapi.CommitmentWorkLife},
+				EasyApply:         &yes,
+				VerifiedJob:       &no,
+				Under10Applicants: &yes,
+				FairChance:        &no,
+			},
+			want: map[string]string{
+				"keyword":           "Software Engineer",
+				"start":             "25",
+				"count":             "50",
+				"sortBy":            "date_posted",
+				"datePosted":        "24h",
+				"experience":        "entry_level,executive",
+				"jobTypes":          "full_time,other",
+				"workplaceTypes":    "remote",
+				"salary":            "90k",
+				"companies":         "1441",
+				"industries":        "4,6",
+				"locations":         "103644278",
+				"functions":         "it,eng",
+				"titles":            "9",
+				"Benefits":          "medical_ins,401k",
+				"commitments":       "dei,work_life",
+				"easyApply":         "true",
+				"verifiedJob":       "false",
+				"under10Applicants": "true",
+				"fairChance":        "false",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := linkdapitest.NewServer("test_key")
+			defer srv.Close()
+
+			client := srv.NewClient(nil)
+			defer client.Close()
+
+			if _, err := client.SearchJobsV2(tt.params); err != nil {
+				t.Fatalf("SearchJobsV2: %v", err)
+			}
+			reqs := srv.RequestsTo("api/v1/search/jobs")
+			if len(reqs) != 1 {
+				t.Fatalf("got %d requests, want 1", len(reqs))
+			}
+			assertQuery(t, reqs[0].Query, tt.want)
+		})
+	}
+}
+
+func TestSearchParamsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     interface{ Validate() error }
+		wantFields []string
+	}{
+		{"valid job search", linkdapi.JobSearchParams{JobTypes: []linkdapi.JobType{"full_time"}, TimePosted: "any"}, nil},
+		{"empty job search", linkdapi.JobSearchParams{}, nil},
+		{
+			name: "invalid job search",
+			params: linkdapi.JobSearchParams{
+				JobTypes:        []linkdapi.JobType{"fulltime", "other"},
+				Experience:      []linkdapi.ExperienceLevel{"executive"},
+				Salary:          "90k",
+				WorkArrangement: []linkdapi.WorkArrangement{""},
+			},
+			wantFields: []string{"JobTypes", "JobTypes", "Experience", "Salary", "WorkArrangement"},
+		},
+		{
+			name: "invalid job search v2",
+			params: linkdapi.JobSearchV2Params{
+				SortBy:      "newest",
+				DatePosted:  "any",
+				Benefits:    []linkdapi.Benefit{"medical"},
+				Commitments: []linkdapi.Commitment{"dei", "diversity"},
+			},
+			wantFields: []string{"SortBy", "DatePosted", "Benefits", "Commitments"},
+		},
+		{"invalid company search", linkdapi.CompanySearchParams{CompanySize: []linkdapi.CompanySize{"1-10", "10-50"}}, []string{"CompanySize"}},
+		{
+			name:       "out of range counts",
+			params:     linkdapi.JobSearchV2Params{Start: linkdapi.Int(-25), Count: linkdapi.Int(51)},
+			wantFields: []string{"Start", "Count"},
+		},
+		{"zero count", linkdapi.PeopleSearchParams{Count: linkdapi.Int(0)}, []string{"Count"}},
+		{"max count", linkdapi.ServiceSearchParams{Start: linkdapi.Int(0), Count: linkdapi.Int(50)}, nil},
+		{
+			name:       "invalid post search",
+			params:     linkdapi.PostSearchParams{ContentType: "video", DatePosted: "past-day", SortBy: linkdapi.SortByDatePosted},
+			wantFields: []string{"ContentType", "DatePosted"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("Validate: %v", err)
+				}
+				return
+			}
+
+			var verr *linkdapi.ValidationError
+			if !errors.As(err, &verr) || !errors.Is(err, linkdapi.ErrInvalidParams) {
+				t.Fatalf("err = %v, want *ValidationError", err)
+			}
+			if len(verr.Fields) != len(tt.wantFields) {
+				t.Fatalf("fields = %v, want %v", verr.Fields, tt.wantFields)
+			}
+			for i, field := range tt.wantFields {
+				if verr.Fields[i].Field != field {
+					t.Errorf("fields[%d] = %s, want %s", i, verr.Fields[i].Field, field)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchJobsRejectsInvalidParams(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	if _, err := client.SearchJobs(linkdapi.JobSearchParams{TimePosted: "1 week"}); !errors.Is(err, linkdapi.ErrInvalidParams) {
+		t.Errorf("SearchJobs err = %v, want ErrInvalidParams", err)
+	}
+	if _, err := client.SearchJobsV2(linkdapi.JobSearchV2Params{Salary: "120k"}); !errors.Is(err, linkdapi.ErrInvalidParams) {
+		t.Errorf("SearchJobsV2 err = %v, want ErrInvalidParams", err)
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Errorf("invalid params sent %d requests, want 0", n)
+	}
+}
@@ -5,45 +5,94 @@ package linkdapi
 // GetFeaturedPosts gets all featured posts for a given profile using its URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/posts/featured
-func (c *Client) GetFeaturedPosts(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/posts/featured", params)
+func (c *Client) GetFeaturedPosts(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/posts/featured", params, o)
 }
 
 // GetAllPosts retrieves all posts for a given profile URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/posts/all
-func (c *Client) GetAllPosts(urn string, cursor string, start int) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	stringParam(params, "cursor", cursor)
-	intParam(params, "start", start)
-	return c.sendRequest("GET", "api/v1/posts/all", params)
+func (c *Client) GetAllPosts(urn string, cursor string, start int, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart|acceptsCursor)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string)
+	if err := startParam(params, o.startOr(start)); err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params["urn"] = profileURN.String()
+	stringParam(params, "cursor", o.cursorOr(cursor))
+	return c.sendRequest("GET", "api/v1/posts/all", params, o)
 }
 
 // GetPostInfo retrieves information about a specific post using its URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/posts/info
-func (c *Client) GetPostInfo(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/posts/info", params)
+func (c *Client) GetPostInfo(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	postURN, err := ParsePostURN(urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": postURN.String()}
+	return c.sendRequest("GET", "api/v1/posts/info", params, o)
 }
 
-// GetPostComments gets comments for a specific LinkedIn post.
+// GetPostComments gets comments for a specific LinkedIn post. A zero start or
+// count is omitted so the API default applies; count must not exceed 50.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/posts/comments
-func (c *Client) GetPostComments(urn string, start int, count int, cursor string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	intParam(params, "start", start)
-	intParam(params, "count", count)
-	stringParam(params, "cursor", cursor)
-	return c.sendRequest("GET", "api/v1/posts/comments", params)
+func (c *Client) GetPostComments(urn string, start int, count int, cursor string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart|acceptsCount|acceptsCursor)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string)
+	if err := pageParams(params, o.startOr(start), o.countOr(count), postCommentsLimits); err != nil {
+		return nil, err
+	}
+	postURN, err := ParsePostURN(urn)
+	if err != nil {
+		return nil, err
+	}
+	params["urn"] = postURN.String()
+	stringParam(params, "cursor", o.cursorOr(cursor))
+	return c.sendRequest("GET", "api/v1/posts/comments", params, o)
 }
 
 // GetPostLikes retrieves all users who liked or reacted to a given post.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/posts/likes
-func (c *Client) GetPostLikes(urn string, start int) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	intParam(params, "start", start)
-	return c.sendRequest("GET", "api/v1/posts/likes", params)
+func (c *Client) GetPostLikes(urn string, start int, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string)
+	if err := startParam(params, o.startOr(start)); err != nil {
+		return nil, err
+	}
+	postURN, err := ParsePostURN(urn)
+	if err != nil {
+		return nil, err
+	}
+	params["urn"] = postURN.String()
+	return c.sendRequest("GET", "api/v1/posts/likes", params, o)
 }
@@ -0,0 +1,352 @@
+// Package postwatch follows companies' posts and samples their engagement
+// over time, building a time series of reactions and comments per post.
+//
+// Basic usage:
+//
+//	w, err := postwatch.New(client, []string{"google", "microsoft"},
+//	    postwatch.NewFileStore("postwatch"), &postwatch.Options{Interval: time.Hour})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	go w.Run(ctx)
+//	for e := range w.Events() {
+//	    fmt.Println(e) // New posts
+//	}
+//
+// Later, export the collected samples:
+//
+//	posts, err := w.Posts()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	postwatch.WriteCSV(os.Stdout, posts)
+//
+// Tracked posts are kept in the Store, so a restarted watcher keeps adding
+// to the same series.
+package postwatch
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/internal/apiutil"
+)
+
+// API is the part of the LinkdAPI client the watcher uses. *linkdapi.Client
+// and the linkdapimock.Client implement it.
+type API interface {
+	linkdapi.CompanyAPI
+	linkdapi.PostAPI
+}
+
+// Options configures a Watcher.
+type Options struct {
+	// Interval is the time between polls in Run (default: 1 hour)
+	// Each poll looks for new posts and samples every tracked post, with one
+	// GetPostInfo call per post
+	Interval time.Duration
+
+	// TrackFor is how long after it is first seen a post is sampled (default: 7 days)
+	TrackFor time.Duration
+
+	// EmitInitial emits PostPublished for the posts found by a company's first poll (default: false)
+	EmitInitial bool
+
+	// OnError, if set, is called with errors that do not stop Run, such as a
+	// failed sample. Failed calls are retried on the next poll.
+	OnError func(company string, err error)
+}
+
+// Post is a tracked post and its engagement samples, oldest first.
+type Post struct {
+	URN       string    `json:"urn"`
+	Company   string    `json:"company"` // As passed to New
+	Text      string    `json:"text,omitempty"`
+	FirstSeen time.Time `json:"firstSeen"`
+	Samples   []Sample  `json:"samples,omitempty"`
+}
+
+// ErrNoCounts is returned when a post's GetPostInfo response reports no
+// reaction or comment count. The post is left unsampled for that poll
+// rather than sampled with made-up counts.
+var ErrNoCounts = errors.New("postwatch: response has no engagement counts")
+
+// Sample is the engagement of a post at one point in time, as reported by
+// GetPostInfo.
+type Sample struct {
+	At        time.Time `json:"at"`
+	Reactions int       `json:"reactions"`
+	Comments  int       `json:"comments"`
+}
+
+// EventType is the kind of an Event.
+type EventType string
+
+const (
+	PostPublished EventType = "post_published" // A post appeared in a company's feed
+	PostSampled   EventType = "post_sampled"   // A post's engagement was sampled
+)
+
+// Event reports a new post or a new sample.
+type Event struct {
+	Type    EventType
+	Company string
+	URN     string
+	At      time.Time
+	Text    string         // For PostPublished
+	Sample  Sample         // For PostSampled
+	Data    map[string]any // The feed item for PostPublished
+}
+
+// String describes the event, e.g. "google: post_sampled: 7123 (12 reactions, 3 comments)".
+func (e Event) String() string {
+	if e.Type == PostSampled {
+		return fmt.Sprintf("%s: %s: %s (%d reactions, %d comments)", e.Company, e.Type, e.URN, e.Sample.Reactions, e.Sample.Comments)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.Company, e.Type, e.URN)
+}
+
+// Watcher follows companies' posts. Create one with New.
+type Watcher struct {
+	api       API
+	companies []string
+	store     Store
+	opts      Options
+	events    chan Event
+
+	mu sync.Mutex // Held while polling, so Run and Poll may overlap
+}
+
+// New creates a watcher for companies, given as IDs, URNs or names, keeping
+// tracked posts in store.
+func New(api API, companies []string, store Store, opts *Options) (*Watcher, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	w := &Watcher{
+		api:       api,
+		companies: companies,
+		store:     store,
+		opts:      *opts,
+		events:    make(chan Event),
+	}
+	if w.opts.Interval <= 0 {
+		w.opts.Interval = time.Hour
+	}
+	if w.opts.TrackFor <= 0 {
+		w.opts.TrackFor = 7 * 24 * time.Hour
+	}
+
+	seen := make(map[string]bool, len(companies))
+	for _, c := range companies {
+		switch {
+		case strings.TrimSpace(c) == "":
+			return nil, errors.New("postwatch: empty company")
+		case seen[c]:
+			return nil, fmt.Errorf("postwatch: duplicate company %q", c)
+		}
+		seen[c] = true
+	}
+	return w, nil
+}
+
+// Events returns the channel Run sends events on. It is closed when Run returns.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Run polls every company now and then once per interval until ctx is done,
+// sending events on Events. It returns the context's error.
+func (w *Watcher) Run(ctx context.Context) error {
+	return apiutil.Watch(ctx, w.opts.Interval, w.companies, w.poll, w.opts.OnError, w.events)
+}
+
+// Poll polls every company once and returns the events, without sending
+// them on Events. Use it to drive the watcher from a scheduler instead of Run.
+// Companies that fail are reported in the joined error; the others still run.
+func (w *Watcher) Poll() ([]Event, error) {
+	return apiutil.PollAll(w.companies, w.poll, func(company string, err error) error {
+		return fmt.Errorf("postwatch: company %q: %w", company, err)
+	})
+}
+
+// Posts returns the tracked posts of every company with their samples,
+// ordered by company, then by when they were first seen.
+func (w *Watcher) Posts() ([]Post, error) {
+	var all []Post
+	for _, company := range w.companies {
+		posts, err := w.store.Load(company)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, sortedPosts(posts)...)
+	}
+	return all, nil
+}
+
+// poll looks for new posts of a company, samples its tracked posts and
+// saves them. Events found before a failed sample are still returned, but
+// none are if the posts cannot be saved.
+func (w *Watcher) poll(company string) ([]Event, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	posts, err := w.store.Load(company)
+	initial := errors.Is(err, ErrNotFound)
+	if err != nil && !initial {
+		return nil, err
+	}
+	if posts == nil {
+		posts = make(map[string]Post)
+	}
+
+	resp, err := w.api.GetCompanyPosts(company, 0)
+	if err != nil {
+		return nil, err
+	}
+	items, err := apiutil.Items(resp, "posts")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var events []Event
+	for _, item := range items {
+		urn := postURN(item)
+		if urn == "" {
+			continue
+		}
+		if _, known := posts[urn]; known {
+			continue
+		}
+		p := Post{URN: urn, Company: company, Text: text(item), FirstSeen: now}
+		posts[urn] = p
+		if !initial || w.opts.EmitInitial {
+			events = append(events, Event{Type: PostPublished, Company: company, URN: urn, At: now, Text: p.Text, Data: item})
+		}
+	}
+
+	var errs []error
+	for _, p := range sortedPosts(posts) {
+		if now.Sub(p.FirstSeen) > w.opts.TrackFor {
+			continue
+		}
+		s, err := w.sample(p.URN)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to sample post %s: %w", p.URN, err))
+			continue
+		}
+		s.At = now
+		p.Samples = append(p.Samples, s)
+		posts[p.URN] = p
+		events = append(events, Event{Type: PostSampled, Company: company, URN: p.URN, At: now, Sample: s})
+	}
+
+	if err := w.store.Save(company, posts); err != nil {
+		return nil, err
+	}
+	return events, errors.Join(errs...)
+}
+
+// sample fetches the current reaction and comment counts of a post.
+func (w *Watcher) sample(urn string) (Sample, error) {
+	resp, err := w.api.GetPostInfo(urn)
+	if err != nil {
+		return Sample{}, err
+	}
+	data, err := apiutil.Data(resp)
+	if err != nil {
+		return Sample{}, err
+	}
+	post, _ := data.(map[string]any)
+	reactions, ok := count(post, "totalReactionCount", "reactionsCount", "numReactions", "likesCount", "numLikes")
+	if !ok {
+		return Sample{}, fmt.Errorf("%w: no reaction count", ErrNoCounts)
+	}
+	comments, ok := count(post, "commentsCount", "numComments", "totalComments")
+	if !ok {
+		return Sample{}, fmt.Errorf("%w: no comment count", ErrNoCounts)
+	}
+	return Sample{Reactions: reactions, Comments: comments}, nil
+}
+
+// WriteCSV writes one row per sample: company, post URN, time (RFC 3339),
+// reactions and comments, after a header row.
+func WriteCSV(w io.Writer, posts []Post) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"company", "urn", "at", "reactions", "comments"})
+	for _, p := range posts {
+		for _, s := range p.Samples {
+			cw.Write([]string{p.Company, p.URN, s.At.UTC().Format(time.RFC3339),
+				strconv.Itoa(s.Reactions), strconv.Itoa(s.Comments)})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func sortedPosts(posts map[string]Post) []Post {
+	sorted := make([]Post, 0, len(posts))
+	for _, p := range posts {
+		sorted = append(sorted, p)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].FirstSeen.Equal(sorted[j].FirstSeen) {
+			return sorted[i].FirstSeen.Before(sorted[j].FirstSeen)
+		}
+		return sorted[i].URN < sorted[j].URN
+	})
+	return sorted
+}
+
+// count returns the first of keys holding a number in post or in its
+// "engagement" or "stats" object.
+func count(post map[string]any, keys ...string) (int, bool) {
+	for _, obj := range []any{post, post["engagement"], post["stats"]} {
+		m, _ := obj.(map[string]any)
+		for _, key := range keys {
+			if n, ok := m[key].(float64); ok {
+				return int(n), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// postURN returns the URN of a feed item, normalized by ParsePostURN where
+// possible.
+func postURN(item map[string]any) string {
+	for _, key := range []string{"urn", "postUrn", "activityUrn", "shareUrn", "id"} {
+		s := apiutil.String(item[key])
+		if s == "" {
+			continue
+		}
+		if urn, err := linkdapi.ParsePostURN(s); err == nil {
+			return urn.String()
+		}
+		return s
+	}
+	return ""
+}
+
+func text(item map[string]any) string {
+	for _, key := range []string{"text", "commentary", "content"} {
+		if s, ok := item[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
@@ -0,0 +1,165 @@
+package postwatch
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapimock"
+)
+
+const (
+	post1 = "7211111111111111111"
+	post2 = "7222222222222222222"
+)
+
+// feed returns a GetCompanyPosts response listing urns.
+func feed(urns ...string) linkdapimock.Result {
+	posts := make([]any, len(urns))
+	for i, urn := range urns {
+		posts[i] = map[string]any{"urn": urn, "text": "Post " + urn}
+	}
+	return linkdapimock.Result{Response: map[string]any{"success": true, "data": map[string]any{"posts": posts}}}
+}
+
+// postInfo answers GetPostInfo with the given counts for every post.
+func postInfo(mock *linkdapimock.Client, data map[string]any) {
+	mock.GetPostInfoFunc = func(urn string, _ ...linkdapi.RequestOption) (map[string]any, error) {
+		return map[string]any{"success": true, "data": data}, nil
+	}
+}
+
+func eventTypes(events []Event) []string {
+	var types []string
+	for _, e := range events {
+		types = append(types, string(e.Type)+" "+e.URN)
+	}
+	return types
+}
+
+func TestPollNewPosts(t *testing.T) {
+	mock := &linkdapimock.Client{}
+	mock.On("GetCompanyPosts", feed(post1), feed(post1, post2))
+	postInfo(mock, map[string]any{"totalReactionCount": 12.0, "commentsCount": 3.0})
+
+	w, err := New(mock, []string{"google"}, NewMemoryStore(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := w.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := eventTypes(events), []string{"post_sampled " + post1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("first Poll = %v, want %v", got, want)
+	}
+
+	events, err = w.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"post_published " + post2, "post_sampled " + post1, "post_sampled " + post2}
+	if got := eventTypes(events); !reflect.DeepEqual(got, want) {
+		t.Errorf("second Poll = %v, want %v", got, want)
+	}
+	if s := events[1].Sample; s.Reactions != 12 || s.Comments != 3 {
+		t.Errorf("Sample = %+v, want 12 reactions and 3 comments", s)
+	}
+	if calls := mock.GetPostInfoCalls(); len(calls) != 3 {
+		t.Errorf("GetPostInfo calls = %d, want one per sample", len(calls))
+	}
+
+	posts, err := w.Posts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 2 || posts[0].URN != post1 || len(posts[0].Samples) != 2 {
+		t.Errorf("Posts = %+v, want %s with two samples first", posts, post1)
+	}
+}
+
+func TestPollEmitInitial(t *testing.T) {
+	mock := &linkdapimock.Client{}
+	mock.On("GetCompanyPosts", feed(post1))
+	postInfo(mock, map[string]any{"engagement": map[string]any{"numLikes": 1.0, "numComments": 0.0}})
+
+	w, err := New(mock, []string{"google"}, NewMemoryStore(), &Options{EmitInitial: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := w.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := eventTypes(events), []string{"post_published " + post1, "post_sampled " + post1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Poll = %v, want %v", got, want)
+	}
+}
+
+func TestPollTrackFor(t *testing.T) {
+	store := NewMemoryStore()
+	store.Save("google", map[string]Post{
+		post1: {URN: post1, Company: "google", FirstSeen: time.Now().Add(-3 * time.Hour)},
+		post2: {URN: post2, Company: "google", FirstSeen: time.Now().Add(-time.Hour)},
+	})
+	mock := &linkdapimock.Client{}
+	mock.On("GetCompanyPosts", feed())
+	postInfo(mock, map[string]any{"totalReactionCount": 1.0, "commentsCount": 1.0})
+
+	w, err := New(mock, []string{"google"}, store, &Options{TrackFor: 2 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := w.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := eventTypes(events), []string{"post_sampled " + post2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Poll = %v, want only the post within TrackFor sampled", got)
+	}
+}
+
+func TestPollMissingCounts(t *testing.T) {
+	mock := &linkdapimock.Client{}
+	mock.On("GetCompanyPosts", feed(post1))
+	postInfo(mock, map[string]any{"text": "no counts", "comments": []any{map[string]any{}}})
+
+	w, err := New(mock, []string{"google"}, NewMemoryStore(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := w.Poll()
+	if !errors.Is(err, ErrNoCounts) {
+		t.Errorf("Poll err = %v, want ErrNoCounts", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Poll = %v, want no samples", eventTypes(events))
+	}
+	posts, _ := w.Posts()
+	if len(posts) != 1 || len(posts[0].Samples) != 0 {
+		t.Errorf("Posts = %+v, want the post tracked without samples", posts)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	posts := []Post{
+		{URN: post1, Company: "google", Samples: []Sample{{At: at, Reactions: 12, Comments: 3}, {At: at.Add(time.Hour), Reactions: 15, Comments: 4}}},
+		{URN: post2, Company: "acme, inc", Samples: []Sample{{At: at, Reactions: 0, Comments: 0}}},
+	}
+
+	var b strings.Builder
+	if err := WriteCSV(&b, posts); err != nil {
+		t.Fatal(err)
+	}
+	want := "company,urn,at,reactions,comments\n" +
+		"google," + post1 + ",2026-10-01T10:00:00Z,12,3\n" +
+		"google," + post1 + ",2026-10-01T11:00:00Z,15,4\n" +
+		`"acme, inc",` + post2 + ",2026-10-01T10:00:00Z,0,0\n"
+	if b.String() != want {
+		t.Errorf("WriteCSV =\n%s\nwant\n%s", b.String(), want)
+	}
+}
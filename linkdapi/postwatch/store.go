@@ -0,0 +1,71 @@
+package postwatch
+
+import (
+	"errors"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/internal/apiutil"
+)
+
+// ErrNotFound is returned by Store.Load for a company that was never saved.
+var ErrNotFound = errors.New("postwatch: not found")
+
+// Store keeps each company's tracked posts, keyed by post URN.
+//
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns a company's tracked posts, or ErrNotFound if it was never saved.
+	Load(company string) (map[string]Post, error)
+
+	// Save replaces a company's tracked posts.
+	Save(company string, posts map[string]Post) error
+}
+
+// MemoryStore is a Store held in memory. It does not survive restarts.
+type MemoryStore struct {
+	store *apiutil.MemoryStore[map[string]Post]
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{store: apiutil.NewMemoryStore(ErrNotFound, copyPosts)}
+}
+
+// Load returns a company's tracked posts, or ErrNotFound.
+func (m *MemoryStore) Load(company string) (map[string]Post, error) {
+	return m.store.Load(company)
+}
+
+// Save replaces a company's tracked posts.
+func (m *MemoryStore) Save(company string, posts map[string]Post) error {
+	return m.store.Save(company, posts)
+}
+
+// copyPosts copies posts, including their samples.
+func copyPosts(posts map[string]Post) map[string]Post {
+	c := make(map[string]Post, len(posts))
+	for urn, p := range posts {
+		p.Samples = append([]Sample(nil), p.Samples...)
+		c[urn] = p
+	}
+	return c
+}
+
+// FileStore is a Store writing one JSON file per company to a directory.
+type FileStore struct {
+	store *apiutil.FileStore[map[string]Post]
+}
+
+// NewFileStore creates a store in dir, which is created on the first Save.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{store: apiutil.NewFileStore[map[string]Post](dir, "postwatch", ErrNotFound)}
+}
+
+// Load returns a company's tracked posts, or ErrNotFound.
+func (f *FileStore) Load(company string) (map[string]Post, error) {
+	return f.store.Load(company)
+}
+
+// Save replaces a company's tracked posts. The file is replaced atomically.
+func (f *FileStore) Save(company string, posts map[string]Post) error {
+	return f.store.Save(company, posts)
+}
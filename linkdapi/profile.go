@@ -7,145 +7,292 @@ import "fmt"
 // GetProfileOverview gets basic profile information by username.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/overview
-func (c *Client) GetProfileOverview(username string) (map[string]any, error) {
-	params := map[string]string{"username": username}
-	return c.sendRequest("GET", "api/v1/profile/overview", params)
+func (c *Client) GetProfileOverview(username string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	name, err := c.resolver.Username(username)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"username": name.String()}
+	return c.sendRequest("GET", "api/v1/profile/overview", params, o)
 }
 
 // GetProfileDetails gets profile details information by URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/details
-func (c *Client) GetProfileDetails(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/details", params)
+func (c *Client) GetProfileDetails(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/details", params, o)
 }
 
 // GetContactInfo gets contact details for a profile by username.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/contact-info
-func (c *Client) GetContactInfo(username string) (map[string]any, error) {
-	params := map[string]string{"username": username}
-	return c.sendRequest("GET", "api/v1/profile/contact-info", params)
+func (c *Client) GetContactInfo(username string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	name, err := c.resolver.Username(username)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"username": name.String()}
+	return c.sendRequest("GET", "api/v1/profile/contact-info", params, o)
 }
 
 // GetFullExperience gets complete work experience by URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/full-experience
-func (c *Client) GetFullExperience(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/full-experience", params)
+func (c *Client) GetFullExperience(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/full-experience", params, o)
 }
 
 // GetCertifications gets lists of professional certifications by URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/certifications
-func (c *Client) GetCertifications(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/certifications", params)
+func (c *Client) GetCertifications(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/certifications", params, o)
 }
 
 // GetEducation gets full education information by URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/education
-func (c *Client) GetEducation(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/education", params)
+func (c *Client) GetEducation(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/education", params, o)
 }
 
 // GetSkills gets profile skills by URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/skills
-func (c *Client) GetSkills(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/skills", params)
+func (c *Client) GetSkills(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/skills", params, o)
 }
 
 // GetSocialMatrix gets social network metrics by username.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/social-matrix
-func (c *Client) GetSocialMatrix(username string) (map[string]any, error) {
-	params := map[string]string{"username": username}
-	return c.sendRequest("GET", "api/v1/profile/social-matrix", params)
+func (c *Client) GetSocialMatrix(username string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	name, err := c.resolver.Username(username)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"username": name.String()}
+	return c.sendRequest("GET", "api/v1/profile/social-matrix", params, o)
 }
 
 // GetRecommendations gets profile given and received recommendations by URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/recommendations
-func (c *Client) GetRecommendations(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/recommendations", params)
+func (c *Client) GetRecommendations(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/recommendations", params, o)
 }
 
 // GetSimilarProfiles gets similar profiles for a given profile using its URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/similar
-func (c *Client) GetSimilarProfiles(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/similar", params)
+func (c *Client) GetSimilarProfiles(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/similar", params, o)
 }
 
 // GetProfileAbout gets about this profile such as last update and verification info.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/about
-func (c *Client) GetProfileAbout(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/about", params)
+func (c *Client) GetProfileAbout(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/about", params, o)
 }
 
 // GetProfileReactions gets all reactions for given profile by URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/reactions
-func (c *Client) GetProfileReactions(urn string, cursor string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	stringParam(params, "cursor", cursor)
-	return c.sendRequest("GET", "api/v1/profile/reactions", params)
+func (c *Client) GetProfileReactions(urn string, cursor string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsCursor)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	stringParam(params, "cursor", o.cursorOr(cursor))
+	return c.sendRequest("GET", "api/v1/profile/reactions", params, o)
 }
 
 // GetProfileInterests gets profile interests by URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/interests
-func (c *Client) GetProfileInterests(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/interests", params)
+func (c *Client) GetProfileInterests(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/interests", params, o)
 }
 
 // GetFullProfile gets full profile data in 1 request (everything included).
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/full
-func (c *Client) GetFullProfile(username, urn string) (map[string]any, error) {
+func (c *Client) GetFullProfile(username, urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
 	if username == "" && urn == "" {
 		return nil, fmt.Errorf("either username or urn must be provided")
 	}
 
 	params := make(map[string]string)
-	stringParam(params, "username", username)
-	stringParam(params, "urn", urn)
+	if username != "" {
+		name, err := ParseUsername(username)
+		if err != nil {
+			return nil, err
+		}
+		params["username"] = name.String()
+	}
+	if urn != "" {
+		profileURN, err := ParseProfileURN(urn)
+		if err != nil {
+			return nil, err
+		}
+		params["urn"] = profileURN.String()
+	}
 
-	return c.sendRequest("GET", "api/v1/profile/full", params)
+	return c.sendRequest("GET", "api/v1/profile/full", params, o)
 }
 
 // GetProfileServices gets profile services by URN.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/services
-func (c *Client) GetProfileServices(urn string) (map[string]any, error) {
-	params := map[string]string{"urn": urn}
-	return c.sendRequest("GET", "api/v1/profile/services", params)
+func (c *Client) GetProfileServices(urn string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, urn)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"urn": profileURN.String()}
+	return c.sendRequest("GET", "api/v1/profile/services", params, o)
 }
 
 // GetProfileURN gets profile URN from username.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/profile/username-to-urn
-func (c *Client) GetProfileURN(username string) (map[string]any, error) {
-	params := map[string]string{"username": username}
-	return c.sendRequest("GET", "api/v1/profile/username-to-urn", params)
+func (c *Client) GetProfileURN(username string, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	name, err := ParseUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{"username": name.String()}
+	return c.sendRequest("GET", "api/v1/profile/username-to-urn", params, o)
 }
 
-// GetProfilePostedJobs gets all jobs posted by a profile using its URN.
+// GetProfilePostedJobs gets all jobs posted by a profile using its URN. A zero
+// start or count is omitted so the API default applies; count must not exceed 50.
 //
 // Documentation: https://linkdapi.com/docs?endpoint=/api/v1/jobs/posted-by-profile
-func (c *Client) GetProfilePostedJobs(profileUrn string, start, count int) (map[string]any, error) {
-	params := map[string]string{"profileUrn": profileUrn}
-	intParam(params, "start", start)
-	intParam(params, "count", count)
-	return c.sendRequest("GET", "api/v1/jobs/posted-by-profile", params)
+func (c *Client) GetProfilePostedJobs(profileUrn string, start, count int, opts ...RequestOption) (map[string]any, error) {
+	o, err := newRequestOptions(opts, acceptsStart|acceptsCount)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string)
+	if err := pageParams(params, o.startOr(start), o.countOr(count), postedJobsLimits); err != nil {
+		return nil, err
+	}
+	profileURN, err := c.resolver.profileURN(o, profileUrn)
+	if err != nil {
+		return nil, err
+	}
+	params["profileUrn"] = profileURN.String()
+	return c.sendRequest("GET", "api/v1/jobs/posted-by-profile", params, o)
 }
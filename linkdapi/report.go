@@ -0,0 +1,133 @@
+package linkdapi
+
+// CompanySection names a part of a company fetched by BuildCompanyReport.
+type CompanySection string
+
+const (
+	CompanySectionInfo            CompanySection = "info"             // GetCompanyInfo
+	CompanySectionDetails         CompanySection = "details"          // GetCompanyDetailsV2
+	CompanySectionEmployees       CompanySection = "employees"        // GetCompanyEmployeesData
+	CompanySectionJobs            CompanySection = "jobs"             // GetCompanyJobs, first page
+	CompanySectionSimilar         CompanySection = "similar"          // GetSimilarCompanies
+	CompanySectionAffiliatedPages CompanySection = "affiliated_pages" // GetCompanyAffiliatedPages
+	CompanySectionPosts           CompanySection = "posts"            // GetCompanyPosts, first page
+)
+
+// CompanySections lists every section, the default for BuildCompanyReport.
+var CompanySections = []CompanySection{
+	CompanySectionInfo, CompanySectionDetails, CompanySectionEmployees, CompanySectionJobs,
+	CompanySectionSimilar, CompanySectionAffiliatedPages, CompanySectionPosts,
+}
+
+// CompanyReport is a company stitched together from section endpoints.
+// Each section field is nil if the section was not requested or failed.
+// Object and list sections are decoded like those of an AssembledProfile:
+// list sections hold the "data" list, or the list a "data" object holds
+// under the section's name (e.g. "jobs") or as its only list.
+type CompanyReport struct {
+	ID CompanyID
+
+	Info            map[string]any   // Object, e.g. {"name": ..., "industry": ...}
+	Details         map[string]any   // Object
+	Employees       map[string]any   // Object
+	Jobs            []map[string]any // List of jobs, first page
+	Similar         []map[string]any // List of companies
+	AffiliatedPages []map[string]any // List of pages
+	Posts           []map[string]any // List of posts, first page
+
+	Failed map[CompanySection]error // Sections that could not be fetched and why
+}
+
+// Err joins the errors of the failed sections, or returns nil if none failed.
+func (r *CompanyReport) Err() error {
+	return sectionErrors(r.Failed)
+}
+
+// BuildCompanyReport resolves a company by ID, URN, universal name or name,
+// then fetches the given sections concurrently and merges them into one
+// CompanyReport. All sections are fetched if none are given. opts apply to
+// every call, including the name lookup, and WithTimeout bounds them all
+// together rather than each call; pass WithContext to cancel the calls in
+// flight.
+//
+// A failing section is recorded in Failed and does not fail the report; the
+// error is only non-nil if the company cannot be resolved.
+//
+// Example:
+//
+//	report, err := client.BuildCompanyReport("google", nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(report.ID, report.Info["name"], len(report.Jobs))
+//	if err := report.Err(); err != nil {
+//	    log.Printf("partial report: %v", err)
+//	}
+func (c *Client) BuildCompanyReport(nameOrID string, sections []CompanySection, opts ...RequestOption) (*CompanyReport, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(sections) == 0 {
+		sections = CompanySections
+	}
+	var v validator
+	eachOneOf(&v, "sections", sections, CompanySections)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	id, err := c.resolver.companyID(o, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.callContext(o)
+	defer cancel()
+
+	r := &CompanyReport{ID: id}
+	var data map[CompanySection]any
+	// Share the deadline fixed above, rather than restart WithTimeout per section
+	sectionOpts := o.lookup()
+	data, r.Failed = fetchSections(ctx, sections, func(s CompanySection) (map[string]any, error) {
+		switch s {
+		case CompanySectionInfo:
+			return c.GetCompanyInfo(id.String(), "", sectionOpts...)
+		case CompanySectionDetails:
+			return c.GetCompanyDetailsV2(id.String(), sectionOpts...)
+		case CompanySectionEmployees:
+			return c.GetCompanyEmployeesData(id.String(), sectionOpts...)
+		case CompanySectionJobs:
+			return c.GetCompanyJobs([]string{id.String()}, 0, sectionOpts...)
+		case CompanySectionSimilar:
+			return c.GetSimilarCompanies(id.String(), sectionOpts...)
+		case CompanySectionAffiliatedPages:
+			return c.GetCompanyAffiliatedPages(id.String(), sectionOpts...)
+		default:
+			return c.GetCompanyPosts(id.String(), 0, sectionOpts...)
+		}
+	})
+	for s, d := range data {
+		var err error
+		switch s {
+		case CompanySectionInfo:
+			r.Info, err = sectionObject(d)
+		case CompanySectionDetails:
+			r.Details, err = sectionObject(d)
+		case CompanySectionEmployees:
+			r.Employees, err = sectionObject(d)
+		case CompanySectionJobs:
+			r.Jobs, err = sectionList(d, "jobs")
+		case CompanySectionSimilar:
+			r.Similar, err = sectionList(d, "companies", "similar")
+		case CompanySectionAffiliatedPages:
+			r.AffiliatedPages, err = sectionList(d, "pages", "affiliatedPages", "companies")
+		case CompanySectionPosts:
+			r.Posts, err = sectionList(d, "posts")
+		}
+		if err != nil {
+			r.Failed[s] = err
+		}
+	}
+	return r, nil
+}
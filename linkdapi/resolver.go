@@ -0,0 +1,438 @@
+package linkdapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Resolver maps usernames to profile URNs and company names to company IDs,
+// memoizing every mapping it learns. Each Client has a Resolver, which its
+// URN- and ID-based methods use so that they accept either form:
+//
+//	skills, err := client.GetSkills("ryanroslansky") // resolved to the URN once
+//	similar, err := client.GetSimilarCompanies("google") // resolved to the ID once
+//
+// Mappings are kept in memory and, if Config.ResolverCacheFile is set,
+// persisted to that file so they survive restarts.
+//
+// The Resolver is safe for concurrent use by multiple goroutines.
+type Resolver struct {
+	client *Client
+	path   string
+
+	mu        sync.Mutex
+	profiles  map[string]ProfileURN // Lowercased username -> URN
+	companies map[string]CompanyID  // Lowercased name -> ID
+	inflight  map[string]*resolveCall
+
+	saveMu sync.Mutex // Serializes writes to the cache file
+}
+
+type resolveCall struct {
+	done chan struct{}
+	id   string
+	err  error
+}
+
+// resolverCache is the on-disk format of the resolver cache.
+type resolverCache struct {
+	Profiles  map[string]ProfileURN `json:"profiles"`
+	Companies map[string]CompanyID  `json:"companies"`
+}
+
+func newResolver(client *Client, path string) *Resolver {
+	r := &Resolver{
+		client:    client,
+		path:      path,
+		profiles:  make(map[string]ProfileURN),
+		companies: make(map[string]CompanyID),
+		inflight:  make(map[string]*resolveCall),
+	}
+	if path != "" {
+		// A missing or unreadable cache only costs extra lookups
+		r.Load(path)
+	}
+	return r
+}
+
+// Resolver returns the client's resolver.
+func (c *Client) Resolver() *Resolver {
+	return c.resolver
+}
+
+// ProfileURN returns the profile URN for a username or profile URN.
+// URNs are returned as-is; usernames are looked up with GetProfileURN
+// unless already known.
+func (r *Resolver) ProfileURN(usernameOrURN string) (ProfileURN, error) {
+	return r.profileURN(nil, usernameOrURN)
+}
+
+// profileURN implements ProfileURN for a call made with options o, which
+// may be nil. WithNoCache skips the cached mapping.
+func (r *Resolver) profileURN(o *requestOptions, usernameOrURN string) (ProfileURN, error) {
+	urn, learned, err := r.resolveProfile(o, usernameOrURN)
+	if learned {
+		r.persist()
+	}
+	return urn, err
+}
+
+// resolveProfile resolves usernameOrURN without persisting the cache and
+// reports whether this call learned a new mapping.
+func (r *Resolver) resolveProfile(o *requestOptions, usernameOrURN string) (ProfileURN, bool, error) {
+	if urn, err := ParseProfileURN(usernameOrURN); err == nil {
+		return urn, false, nil
+	}
+	name, err := ParseUsername(usernameOrURN)
+	if err != nil {
+		return "", false, fmt.Errorf("%w: %q is neither a profile URN nor a username", ErrInvalidIdentifier, usernameOrURN)
+	}
+
+	key := strings.ToLower(name.String())
+	r.mu.Lock()
+	urn, ok := r.profiles[key]
+	r.mu.Unlock()
+	if ok && (o == nil || !o.noCache) {
+		return urn, false, nil
+	}
+
+	id, learned, err := r.do("profile:"+key, func() (string, error) {
+		resp, err := r.client.GetProfileURN(name.String(), o.lookup()...)
+		if err != nil {
+			return "", err
+		}
+		data, err := responseData(resp)
+		if err != nil {
+			return "", err
+		}
+		urn, err := ParseProfileURN(stringValue(data["urn"]))
+		if err != nil {
+			return "", err
+		}
+
+		r.mu.Lock()
+		r.profiles[key] = urn
+		r.mu.Unlock()
+		return urn.String(), nil
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to resolve profile %s: %w", name, err)
+	}
+	return ProfileURN(id), learned, nil
+}
+
+// Username returns the username for a username or a profile URN. URNs can
+// only be mapped back to usernames the resolver has already seen.
+func (r *Resolver) Username(usernameOrURN string) (Username, error) {
+	urn, err := ParseProfileURN(usernameOrURN)
+	if err != nil {
+		return ParseUsername(usernameOrURN)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, known := range r.profiles {
+		if known == urn {
+			return Username(name), nil
+		}
+	}
+	return "", fmt.Errorf("%w: no known username for profile URN %s; this endpoint needs a username", ErrInvalidIdentifier, urn)
+}
+
+// CompanyID returns the company ID for a company ID, URN or name.
+// Names are looked up with GetCompanyID as a universal name, falling back to
+// the best CompanyNameLookup match, unless already known.
+func (r *Resolver) CompanyID(nameOrID string) (CompanyID, error) {
+	return r.companyID(nil, nameOrID)
+}
+
+// companyID implements CompanyID for a call made with options o, which may
+// be nil. WithNoCache skips the cached mapping.
+func (r *Resolver) companyID(o *requestOptions, nameOrID string) (CompanyID, error) {
+	id, learned, err := r.resolveCompany(o, nameOrID)
+	if learned {
+		r.persist()
+	}
+	return id, err
+}
+
+// resolveCompany resolves nameOrID without persisting the cache and reports
+// whether this call learned a new mapping.
+func (r *Resolver) resolveCompany(o *requestOptions, nameOrID string) (CompanyID, bool, error) {
+	if id, err := ParseCompanyID(nameOrID); err == nil {
+		return id, false, nil
+	}
+	name := strings.TrimSpace(nameOrID)
+	if name == "" {
+		return "", false, fmt.Errorf("%w: empty company name or ID", ErrInvalidIdentifier)
+	}
+
+	key := strings.ToLower(name)
+	r.mu.Lock()
+	id, ok := r.companies[key]
+	r.mu.Unlock()
+	if ok && (o == nil || !o.noCache) {
+		return id, false, nil
+	}
+
+	resolved, learned, err := r.do("company:"+key, func() (string, error) {
+		id, err := r.lookupCompany(o, name)
+		if err != nil {
+			return "", err
+		}
+
+		r.mu.Lock()
+		r.companies[key] = id
+		r.mu.Unlock()
+		return id.String(), nil
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to resolve company %s: %w", name, err)
+	}
+	return CompanyID(resolved), learned, nil
+}
+
+// lookupCompany tries name as a universal name and falls back to a name
+// search only when the API answers but has no ID for it. Request errors,
+// such as ErrBudgetExceeded or a canceled context, are returned as-is.
+func (r *Resolver) lookupCompany(o *requestOptions, name string) (CompanyID, error) {
+	resp, err := r.client.GetCompanyID(name, o.lookup()...)
+	if err != nil {
+		return "", err
+	}
+	if data, err := responseData(resp); err == nil {
+		if id, err := ParseCompanyID(stringValue(data["id"])); err == nil {
+			return id, nil
+		}
+	}
+
+	resp, err = r.client.CompanyNameLookup(name, o.lookup()...)
+	if err != nil {
+		return "", err
+	}
+	if success, _ := resp["success"].(bool); !success {
+		message, _ := resp["message"].(string)
+		return "", fmt.Errorf("%w: %s", ErrUnsuccessful, message)
+	}
+	for _, item := range firstList(resp["data"]) {
+		if m, ok := item.(map[string]any); ok {
+			if id, err := ParseCompanyID(stringValue(m["id"])); err == nil {
+				return id, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no company found for %q", name)
+}
+
+// firstList returns v if it is a list, or the first list found among the
+// values of v if it is an object.
+func firstList(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case map[string]any:
+		for _, value := range v {
+			if list, ok := value.([]any); ok {
+				return list
+			}
+		}
+	}
+	return nil
+}
+
+// ResolveProfiles resolves many usernames or URNs using up to concurrency
+// parallel lookups. The result maps each input to its URN; inputs that
+// failed are missing from it and reported in the joined error. The cache
+// file is written once, after every lookup has finished.
+//
+// opts apply to every lookup, and WithTimeout bounds them all together;
+// pass WithContext to cancel the lookups in flight.
+func (r *Resolver) ResolveProfiles(inputs []string, concurrency int, opts ...RequestOption) (map[string]ProfileURN, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	return resolveAll(r, inputs, concurrency, func(s string) (ProfileURN, bool, error) {
+		return r.resolveProfile(o, s)
+	})
+}
+
+// ResolveCompanies resolves many company names, URNs or IDs using up to
+// concurrency parallel lookups. The result maps each input to its ID;
+// inputs that failed are missing from it and reported in the joined error.
+// The cache file is written once, after every lookup has finished.
+//
+// opts apply to every lookup as for ResolveProfiles.
+func (r *Resolver) ResolveCompanies(inputs []string, concurrency int, opts ...RequestOption) (map[string]CompanyID, error) {
+	o, err := newRequestOptions(opts, 0)
+	if err != nil {
+		return nil, err
+	}
+	return resolveAll(r, inputs, concurrency, func(s string) (CompanyID, bool, error) {
+		return r.resolveCompany(o, s)
+	})
+}
+
+func resolveAll[T any](r *Resolver, inputs []string, concurrency int, resolve func(string) (T, bool, error)) (map[string]T, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		errs    []error
+		learned bool
+		results = make(map[string]T, len(inputs))
+		sem     = make(chan struct{}, concurrency)
+		seen    = make(map[string]bool, len(inputs))
+	)
+	for _, input := range inputs {
+		if seen[input] {
+			continue
+		}
+		seen[input] = true
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+
+			v, isNew, err := resolve(input)
+
+			mu.Lock()
+			defer mu.Unlock()
+			learned = learned || isNew
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			results[input] = v
+		}()
+	}
+	wg.Wait()
+
+	if learned {
+		if err := r.persist(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// do runs lookup once per key at a time, sharing the result with concurrent
+// callers for the same key. learned reports whether this caller ran the
+// lookup and it succeeded, i.e. whether the cache needs persisting.
+func (r *Resolver) do(key string, lookup func() (string, error)) (id string, learned bool, err error) {
+	r.mu.Lock()
+	if call, ok := r.inflight[key]; ok {
+		r.mu.Unlock()
+		<-call.done
+		return call.id, false, call.err
+	}
+	call := &resolveCall{done: make(chan struct{})}
+	r.inflight[key] = call
+	r.mu.Unlock()
+
+	call.id, call.err = lookup()
+
+	r.mu.Lock()
+	delete(r.inflight, key)
+	r.mu.Unlock()
+	close(call.done)
+
+	return call.id, call.err == nil, call.err
+}
+
+// Add records a known username to URN mapping.
+func (r *Resolver) Add(username Username, urn ProfileURN) {
+	r.mu.Lock()
+	r.profiles[strings.ToLower(username.String())] = urn
+	r.mu.Unlock()
+}
+
+// AddCompany records a known company name to ID mapping.
+func (r *Resolver) AddCompany(name string, id CompanyID) {
+	r.mu.Lock()
+	r.companies[strings.ToLower(strings.TrimSpace(name))] = id
+	r.mu.Unlock()
+}
+
+// Forget removes all memoized mappings from memory.
+func (r *Resolver) Forget() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.profiles = make(map[string]ProfileURN)
+	r.companies = make(map[string]CompanyID)
+}
+
+// Load merges mappings from a cache file written by Save.
+func (r *Resolver) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read resolver cache: %w", err)
+	}
+	var cache resolverCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return fmt.Errorf("failed to parse resolver cache %s: %w", path, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, urn := range cache.Profiles {
+		r.profiles[name] = urn
+	}
+	for name, id := range cache.Companies {
+		r.companies[name] = id
+	}
+	return nil
+}
+
+// Save writes all known mappings to path. Concurrent saves are serialized.
+func (r *Resolver) Save(path string) error {
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(resolverCache{Profiles: r.profiles, Companies: r.companies}, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode resolver cache: %w", err)
+	}
+
+	// Write to a temporary file first so a crash never leaves a truncated
+	// cache. CreateTemp keeps other processes sharing the file from
+	// clobbering it and creates it with mode 0600.
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write resolver cache: %w", err)
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write resolver cache: %w", err)
+	}
+	return nil
+}
+
+// persist saves the cache to the configured file, if any.
+func (r *Resolver) persist() error {
+	if r.path == "" {
+		return nil
+	}
+	return r.Save(r.path)
+}
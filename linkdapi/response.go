@@ -0,0 +1,38 @@
+package linkdapi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrUnsuccessful is returned by helpers that need a successful response
+// envelope when the API answers with "success": false.
+var ErrUnsuccessful = errors.New("unsuccessful response")
+
+// responseData returns the "data" object of a successful response envelope.
+func responseData(resp map[string]any) (map[string]any, error) {
+	if success, _ := resp["success"].(bool); !success {
+		message, _ := resp["message"].(string)
+		return nil, fmt.Errorf("%w: %s", ErrUnsuccessful, message)
+	}
+	data, _ := resp["data"].(map[string]any)
+	return data, nil
+}
+
+// stringValue returns v as a string. JSON numbers are formatted as integers
+// when they have no fractional part, so numeric IDs survive decoding.
+func stringValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		if v == float64(int64(v)) {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
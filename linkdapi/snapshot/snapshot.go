@@ -0,0 +1,344 @@
+// Package snapshot records profile snapshots over time and reports what
+// changed between them, for monitoring key accounts for job changes, new
+// skills and certifications, and headline or location edits.
+//
+// Take a snapshot from GetFullProfile or AssembleProfile, then record it:
+//
+//	resp, err := client.GetFullProfile("ryanroslansky", "")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	snap, err := snapshot.FromFullProfile("ryanroslansky", resp)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	store := snapshot.NewFileStore("snapshots")
+//	changes, err := snapshot.Record(store, snap)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, c := range changes {
+//	    fmt.Println(c)
+//	}
+//
+// The first snapshot of a profile has nothing to compare with and yields no
+// changes.
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/internal/apiutil"
+)
+
+// Snapshot is the state of a profile at one point in time, reduced to the
+// fields that changes are detected on.
+type Snapshot struct {
+	Key            string          `json:"key"` // Username or URN identifying the profile
+	TakenAt        time.Time       `json:"takenAt"`
+	Name           string          `json:"name,omitempty"`
+	Headline       string          `json:"headline,omitempty"`
+	Location       string          `json:"location,omitempty"`
+	Positions      []Position      `json:"positions,omitempty"`
+	Skills         []string        `json:"skills,omitempty"`
+	Certifications []Certification `json:"certifications,omitempty"`
+}
+
+// Position is one entry of a profile's work experience.
+type Position struct {
+	Title   string `json:"title,omitempty"`
+	Company string `json:"company,omitempty"`
+	Start   string `json:"start,omitempty"` // As reported by the API, e.g. "2020-03"
+	End     string `json:"end,omitempty"`   // Empty while Current
+	Current bool   `json:"current,omitempty"`
+}
+
+// String returns the position as "Title at Company".
+func (p Position) String() string {
+	switch {
+	case p.Title == "":
+		return p.Company
+	case p.Company == "":
+		return p.Title
+	}
+	return p.Title + " at " + p.Company
+}
+
+// key identifies the position across snapshots.
+func (p Position) key() string {
+	return strings.ToLower(p.Title + "\x00" + p.Company + "\x00" + p.Start)
+}
+
+// Certification is one certification listed on a profile.
+type Certification struct {
+	Name      string `json:"name"`
+	Authority string `json:"authority,omitempty"`
+}
+
+// ErrNoData is returned when a response holds no profile data.
+var ErrNoData = errors.New("snapshot: response has no profile data")
+
+// FromFullProfile takes a snapshot of a GetFullProfile response, identified
+// by key.
+func FromFullProfile(key string, resp map[string]any) (*Snapshot, error) {
+	d, err := apiutil.Data(resp)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	data, ok := d.(map[string]any)
+	if !ok {
+		return nil, ErrNoData
+	}
+
+	s := &Snapshot{Key: key, TakenAt: time.Now()}
+	s.fillOverview(data)
+	s.Positions = positions(data["experience"])
+	s.Skills = skills(data["skills"])
+	s.Certifications = certifications(data["certifications"])
+	return s, nil
+}
+
+// FromAssembled takes a snapshot of an AssembleProfile result, identified by
+// its username, or URN if the username is unknown. Sections that were not
+// fetched are left empty, so compare snapshots assembled from the same
+// sections.
+func FromAssembled(p *linkdapi.AssembledProfile) *Snapshot {
+	key := p.Username.String()
+	if key == "" {
+		key = p.URN.String()
+	}
+
+	s := &Snapshot{Key: key, TakenAt: time.Now()}
+	s.fillOverview(p.Details)
+	s.fillOverview(p.Overview)
+	s.Positions = positions(items(p.Experience))
+	s.Skills = skills(items(p.Skills))
+	s.Certifications = certifications(items(p.Certifications))
+	return s
+}
+
+// items converts a decoded AssembledProfile list section for the list
+// helpers, which also accept raw response data.
+func items(section []map[string]any) []any {
+	out := make([]any, len(section))
+	for i, m := range section {
+		out[i] = m
+	}
+	return out
+}
+
+// fillOverview sets the name, headline and location found in data.
+func (s *Snapshot) fillOverview(data map[string]any) {
+	if v := text(data, "fullName", "name"); v != "" {
+		s.Name = v
+	}
+	if v := text(data, "headline"); v != "" {
+		s.Headline = v
+	}
+	if v := text(data, "location", "locationName", "geoLocation"); v != "" {
+		s.Location = v
+	}
+}
+
+func positions(v any) []Position {
+	var out []Position
+	for _, item := range apiutil.List(v, "experience", "positions") {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		p := Position{
+			Title:   text(m, "title", "position"),
+			Company: text(m, "companyName", "company"),
+			Start:   text(m, "startDate", "start"),
+			End:     text(m, "endDate", "end"),
+		}
+		if p.Title == "" && p.Company == "" {
+			continue
+		}
+		if strings.EqualFold(p.End, "present") {
+			p.End = ""
+		}
+		p.Current = p.End == ""
+		if current, ok := m["isCurrent"].(bool); ok {
+			p.Current = current
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+func skills(v any) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, item := range apiutil.List(v, "skills") {
+		var name string
+		switch item := item.(type) {
+		case string:
+			name = strings.TrimSpace(item)
+		case map[string]any:
+			name = text(item, "name", "skillName", "title")
+		}
+		if name != "" && !seen[strings.ToLower(name)] {
+			seen[strings.ToLower(name)] = true
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func certifications(v any) []Certification {
+	var out []Certification
+	for _, item := range apiutil.List(v, "certifications", "certificates") {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		c := Certification{
+			Name:      text(m, "name", "title"),
+			Authority: text(m, "authority", "issuer", "companyName"),
+		}
+		if c.Name != "" {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// text returns the first of keys holding a non-empty value in m. Objects,
+// such as locations and dates, are reduced to their most descriptive field.
+func text(m map[string]any, keys ...string) string {
+	for _, key := range keys {
+		switch v := m[key].(type) {
+		case map[string]any:
+			if s := text(v, "fullLocation", "default", "name", "text", "city"); s != "" {
+				return s
+			}
+			if year := text(v, "year"); year != "" {
+				if month := text(v, "month"); month != "" {
+					if len(month) == 1 {
+						month = "0" + month
+					}
+					return year + "-" + month
+				}
+				return year
+			}
+		default:
+			if s := apiutil.String(v); s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// ChangeType is the kind of a Change.
+type ChangeType string
+
+const (
+	PositionAdded      ChangeType = "position_added"
+	PositionEnded      ChangeType = "position_ended"
+	HeadlineChanged    ChangeType = "headline_changed"
+	LocationChanged    ChangeType = "location_changed"
+	SkillAdded         ChangeType = "skill_added"
+	CertificationAdded ChangeType = "certification_added"
+)
+
+// Change is one difference between two snapshots of a profile.
+type Change struct {
+	Type ChangeType `json:"type"`
+	Key  string     `json:"key"` // The profile's snapshot key
+	At   time.Time  `json:"at"`  // When the newer snapshot was taken
+
+	Old string `json:"old,omitempty"` // Previous headline or location
+	New string `json:"new,omitempty"` // New headline, location, skill or certification name
+
+	Position *Position `json:"position,omitempty"` // For PositionAdded and PositionEnded
+}
+
+// String describes the change, e.g. "ryanroslansky: position_added: CEO at LinkedIn".
+func (c Change) String() string {
+	switch {
+	case c.Position != nil:
+		return fmt.Sprintf("%s: %s: %s", c.Key, c.Type, c.Position)
+	case c.Old != "":
+		return fmt.Sprintf("%s: %s: %q -> %q", c.Key, c.Type, c.Old, c.New)
+	}
+	return fmt.Sprintf("%s: %s: %s", c.Key, c.Type, c.New)
+}
+
+// Diff returns the changes from prev to next, in a stable order: positions,
+// headline, location, skills, then certifications. Empty fields in next are
+// treated as missing data rather than removals, so a section that failed to
+// load does not produce changes.
+func Diff(prev, next *Snapshot) []Change {
+	var changes []Change
+	add := func(c Change) {
+		c.Key, c.At = next.Key, next.TakenAt
+		changes = append(changes, c)
+	}
+
+	if len(next.Positions) > 0 {
+		before := make(map[string]Position, len(prev.Positions))
+		for _, p := range prev.Positions {
+			before[p.key()] = p
+		}
+		after := make(map[string]bool, len(next.Positions))
+		for _, p := range next.Positions {
+			after[p.key()] = true
+			old, existed := before[p.key()]
+			switch {
+			case !existed:
+				add(Change{Type: PositionAdded, Position: &p})
+			case old.Current && !p.Current:
+				add(Change{Type: PositionEnded, Position: &p})
+			}
+		}
+		// A current position that disappeared has ended too
+		for _, p := range prev.Positions {
+			if p.Current && !after[p.key()] {
+				add(Change{Type: PositionEnded, Position: &p})
+			}
+		}
+	}
+
+	if next.Headline != "" && next.Headline != prev.Headline {
+		add(Change{Type: HeadlineChanged, Old: prev.Headline, New: next.Headline})
+	}
+	if next.Location != "" && next.Location != prev.Location {
+		add(Change{Type: LocationChanged, Old: prev.Location, New: next.Location})
+	}
+
+	known := make(map[string]bool, len(prev.Skills))
+	for _, s := range prev.Skills {
+		known[strings.ToLower(s)] = true
+	}
+	for _, s := range sorted(next.Skills) {
+		if !known[strings.ToLower(s)] {
+			add(Change{Type: SkillAdded, New: s})
+		}
+	}
+
+	certified := make(map[string]bool, len(prev.Certifications))
+	for _, c := range prev.Certifications {
+		certified[strings.ToLower(c.Name)] = true
+	}
+	for _, c := range next.Certifications {
+		if !certified[strings.ToLower(c.Name)] {
+			add(Change{Type: CertificationAdded, New: c.Name})
+		}
+	}
+	return changes
+}
+
+func sorted(s []string) []string {
+	s = append([]string(nil), s...)
+	sort.Strings(s)
+	return s
+}
@@ -0,0 +1,216 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+)
+
+func TestFromFullProfile(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "full_profile.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp map[string]any
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := FromFullProfile("ryanroslansky", resp)
+	if err != nil {
+		t.Fatalf("FromFullProfile: %v", err)
+	}
+	want := &Snapshot{
+		Key:      "ryanroslansky",
+		TakenAt:  s.TakenAt,
+		Name:     "Ryan Roslansky",
+		Headline: "CEO at LinkedIn",
+		Location: "Mountain View, California, United States",
+		Positions: []Position{
+			{Title: "CEO", Company: "LinkedIn", Start: "2020-06", Current: true},
+			{Title: "Executive Vice President, Office of the CEO", Company: "Microsoft", Start: "2023-03", Current: true},
+			{Title: "SVP, Product", Company: "LinkedIn", Start: "2009-05", End: "2020-06"},
+		},
+		Skills:         []string{"Product Management", "Leadership"},
+		Certifications: []Certification{{Name: "Certified Scrum Product Owner", Authority: "Scrum Alliance"}},
+	}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("FromFullProfile =\n%+v\nwant\n%+v", s, want)
+	}
+}
+
+func TestFromFullProfileErrors(t *testing.T) {
+	_, err := FromFullProfile("x", map[string]any{"success": false, "message": "profile not found"})
+	if !errors.Is(err, linkdapi.ErrUnsuccessful) {
+		t.Errorf("unsuccessful response: err = %v, want ErrUnsuccessful", err)
+	}
+	_, err = FromFullProfile("x", map[string]any{"success": true, "data": []any{}})
+	if !errors.Is(err, ErrNoData) {
+		t.Errorf("list data: err = %v, want ErrNoData", err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	ceo := Position{Title: "CEO", Company: "Acme", Start: "2020-01", Current: true}
+	cto := Position{Title: "CTO", Company: "Acme", Start: "2018-01", Current: true}
+	advisor := Position{Title: "Advisor", Company: "Initech", Start: "2019-01", Current: true}
+	at := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	prev := &Snapshot{
+		Key:            "jdoe",
+		Headline:       "CTO at Acme",
+		Location:       "Berlin",
+		Positions:      []Position{cto, advisor},
+		Skills:         []string{"Go"},
+		Certifications: []Certification{{Name: "CKA"}},
+	}
+	ctoEnded := cto
+	ctoEnded.End, ctoEnded.Current = "2020-01", false
+	next := &Snapshot{
+		Key:            "jdoe",
+		TakenAt:        at,
+		Headline:       "CEO at Acme",
+		Location:       "", // Missing, not removed
+		Positions:      []Position{ceo, ctoEnded},
+		Skills:         []string{"Rust", "go", "Kubernetes"},
+		Certifications: []Certification{{Name: "cka"}, {Name: "CKAD"}},
+	}
+
+	got := Diff(prev, next)
+	want := []Change{
+		{Type: PositionAdded, Position: &ceo},
+		{Type: PositionEnded, Position: &ctoEnded},
+		{Type: PositionEnded, Position: &advisor},
+		{Type: HeadlineChanged, Old: "CTO at Acme", New: "CEO at Acme"},
+		{Type: SkillAdded, New: "Kubernetes"},
+		{Type: SkillAdded, New: "Rust"},
+		{Type: CertificationAdded, New: "CKAD"},
+	}
+	for i := range want {
+		want[i].Key, want[i].At = "jdoe", at
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff =\n%v\nwant\n%v", got, want)
+	}
+
+	// Positions missing from next are missing data, not ended positions
+	if got := Diff(prev, &Snapshot{Key: "jdoe", Headline: prev.Headline, Skills: prev.Skills}); len(got) != 0 {
+		t.Errorf("Diff with no positions = %v, want no changes", got)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	stores := map[string]Store{
+		"memory": NewMemoryStore(),
+		"file":   NewFileStore(filepath.Join(t.TempDir(), "snapshots")),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			if _, err := store.Load("jdoe"); !errors.Is(err, ErrNotFound) {
+				t.Fatalf("Load before Record: err = %v, want ErrNotFound", err)
+			}
+
+			first := &Snapshot{Key: "jdoe", Headline: "Engineer", Skills: []string{"Go"}}
+			changes, err := Record(store, first)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != 0 {
+				t.Errorf("first Record = %v, want no changes", changes)
+			}
+
+			second := &Snapshot{Key: "jdoe", Headline: "Staff Engineer", Skills: []string{"Go"}}
+			changes, err = Record(store, second)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != 1 || changes[0].Type != HeadlineChanged || changes[0].Old != "Engineer" {
+				t.Errorf("second Record = %v, want the headline change", changes)
+			}
+
+			latest, err := store.Load("jdoe")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if latest.Headline != "Staff Engineer" {
+				t.Errorf("latest Headline = %q, want the second snapshot's", latest.Headline)
+			}
+		})
+	}
+}
+
+func TestRecordPartial(t *testing.T) {
+	stores := map[string]Store{
+		"memory": NewMemoryStore(),
+		"file":   NewFileStore(filepath.Join(t.TempDir(), "snapshots")),
+	}
+	full := func(headline string) *Snapshot {
+		return &Snapshot{
+			Key:            "jdoe",
+			Headline:       headline,
+			Location:       "Berlin",
+			Positions:      []Position{{Title: "Engineer", Company: "Acme", Current: true}},
+			Skills:         []string{"Go", "SQL"},
+			Certifications: []Certification{{Name: "CKA"}},
+		}
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Record(store, full("Engineer")); err != nil {
+				t.Fatal(err)
+			}
+
+			// Only the overview loaded: the headline change, but no removals
+			partial := &Snapshot{Key: "jdoe", Headline: "Staff Engineer"}
+			changes, err := Record(store, partial)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != 1 || changes[0].Type != HeadlineChanged {
+				t.Errorf("partial Record = %v, want the headline change", changes)
+			}
+			if partial.Skills != nil || partial.Location != "" {
+				t.Errorf("Record modified its snapshot: %+v", partial)
+			}
+
+			// Everything loaded again: nothing changed since the partial snapshot
+			changes, err = Record(store, full("Staff Engineer"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != 0 {
+				t.Errorf("full Record after a partial one = %v, want no changes", changes)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreClones(t *testing.T) {
+	store := NewMemoryStore()
+	s := &Snapshot{Key: "jdoe", Positions: []Position{{Title: "Engineer"}}, Skills: []string{"Go"}, Certifications: []Certification{{Name: "CKA"}}}
+	if err := store.Save(s); err != nil {
+		t.Fatal(err)
+	}
+	s.Positions[0].Title = "changed"
+	s.Skills[0] = "changed"
+	s.Certifications[0].Name = "changed"
+
+	loaded, err := store.Load("jdoe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded.Skills[0] = "changed by the loader"
+	again, err := store.Load("jdoe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Positions[0].Title != "Engineer" || again.Skills[0] != "Go" || again.Certifications[0].Name != "CKA" {
+		t.Errorf("stored snapshot changed with the caller's slices: %+v", again)
+	}
+}
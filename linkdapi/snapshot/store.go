@@ -0,0 +1,113 @@
+package snapshot
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/internal/apiutil"
+)
+
+// ErrNotFound is returned by Store.Load when no snapshot is stored for a key.
+var ErrNotFound = errors.New("snapshot: not found")
+
+// Store keeps the latest snapshot of each profile.
+//
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the latest snapshot for key, or ErrNotFound.
+	Load(key string) (*Snapshot, error)
+
+	// Save replaces the latest snapshot for s.Key.
+	Save(s *Snapshot) error
+}
+
+// Record diffs s against the latest snapshot in store, then saves s as the
+// latest. It returns no changes for the first snapshot of a profile.
+//
+// As Diff treats empty fields as missing data, the saved snapshot keeps the
+// latest one's headline, location, positions, skills and certifications
+// wherever s has none, so a partial snapshot does not erase the baseline the
+// next one is compared with. s itself is not modified.
+func Record(store Store, s *Snapshot) ([]Change, error) {
+	prev, err := store.Load(s.Key)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	var changes []Change
+	if prev != nil {
+		changes = Diff(prev, s)
+		s = fill(s, prev)
+	}
+	if err := store.Save(s); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// MemoryStore is a Store held in memory.
+type MemoryStore struct {
+	store *apiutil.MemoryStore[*Snapshot]
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{store: apiutil.NewMemoryStore(ErrNotFound, func(s *Snapshot) *Snapshot {
+		return fill(s, &Snapshot{})
+	})}
+}
+
+// Load returns the latest snapshot for key, or ErrNotFound.
+func (m *MemoryStore) Load(key string) (*Snapshot, error) {
+	return m.store.Load(key)
+}
+
+// Save replaces the latest snapshot for s.Key.
+func (m *MemoryStore) Save(s *Snapshot) error {
+	return m.store.Save(s.Key, s)
+}
+
+// FileStore is a Store writing one JSON file per profile to a directory.
+type FileStore struct {
+	store *apiutil.FileStore[*Snapshot]
+}
+
+// NewFileStore creates a store in dir, which is created on the first Save.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{store: apiutil.NewFileStore[*Snapshot](dir, "snapshot", ErrNotFound)}
+}
+
+// Load returns the latest snapshot for key, or ErrNotFound.
+func (f *FileStore) Load(key string) (*Snapshot, error) {
+	return f.store.Load(key)
+}
+
+// Save replaces the latest snapshot for s.Key. The file is replaced
+// atomically, so a crash never leaves a partial snapshot.
+func (f *FileStore) Save(s *Snapshot) error {
+	return f.store.Save(s.Key, s)
+}
+
+// fill returns a copy of s, with its own slices, taking the fields Diff
+// treats as missing data from prev where s has none.
+func fill(s, prev *Snapshot) *Snapshot {
+	c := *s
+	if c.Headline == "" {
+		c.Headline = prev.Headline
+	}
+	if c.Location == "" {
+		c.Location = prev.Location
+	}
+	if len(c.Positions) == 0 {
+		c.Positions = prev.Positions
+	}
+	if len(c.Skills) == 0 {
+		c.Skills = prev.Skills
+	}
+	if len(c.Certifications) == 0 {
+		c.Certifications = prev.Certifications
+	}
+	c.Positions = slices.Clone(c.Positions)
+	c.Skills = slices.Clone(c.Skills)
+	c.Certifications = slices.Clone(c.Certifications)
+	return &c
+}
@@ -0,0 +1,194 @@
+package linkdapi
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidURL is returned when a URL is not a recognized LinkedIn URL.
+var ErrInvalidURL = errors.New("invalid LinkedIn URL")
+
+// URLKind classifies a LinkedIn URL.
+type URLKind int
+
+const (
+	URLUnknown URLKind = iota
+	URLProfile         // /in/<username>
+	URLCompany         // /company/<name> or /showcase/<name>
+	URLJob             // /jobs/view/<id> or ?currentJobId=<id>
+	URLPost            // /posts/<slug>-activity-<id>-... or /feed/update/<urn>
+	URLArticle         // /pulse/<slug>
+	URLService         // /services/page/<vanity name>
+)
+
+// String returns the kind name, e.g. "profile".
+func (k URLKind) String() string {
+	switch k {
+	case URLProfile:
+		return "profile"
+	case URLCompany:
+		return "company"
+	case URLJob:
+		return "job"
+	case URLPost:
+		return "post"
+	case URLArticle:
+		return "article"
+	case URLService:
+		return "service"
+	}
+	return "unknown"
+}
+
+// LinkedInURL is a parsed LinkedIn URL. Only the fields for its Kind are set.
+type LinkedInURL struct {
+	Kind URLKind
+	URL  string // Canonical URL without query or fragment
+
+	Username    Username   // URLProfile
+	CompanyName string     // URLCompany: universal name, e.g. "google"
+	JobID       JobID      // URLJob
+	PostURN     PostURN    // URLPost
+	ArticleURL  string     // URLArticle: canonical article URL
+	ServiceName string     // URLService: vanity name
+	ProfileURN  ProfileURN // URLProfile, set by Client.Resolve
+	CompanyID   CompanyID  // URLCompany, set by Client.Resolve or when the URL holds a numeric ID
+}
+
+var (
+	activityPattern = regexp.MustCompile(`(?:^|-)(activity|ugcPost|share)-(\d+)(?:-|$)`)
+	trailingDigits  = regexp.MustCompile(`(\d+)$`)
+)
+
+// ParseLinkedInURL classifies a LinkedIn URL and extracts its identifier.
+// The scheme may be omitted and any linkedin.com subdomain is accepted.
+//
+// Example:
+//
+//	u, err := linkdapi.ParseLinkedInURL("https://www.linkedin.com/in/ryanroslansky/")
+//	// u.Kind == linkdapi.URLProfile, u.Username == "ryanroslansky"
+func ParseLinkedInURL(raw string) (*LinkedInURL, error) {
+	s := strings.TrimSpace(raw)
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	parsed, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidURL, raw, err)
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host != "linkedin.com" && !strings.HasSuffix(host, ".linkedin.com") {
+		return nil, fmt.Errorf("%w: %q is not a linkedin.com URL", ErrInvalidURL, raw)
+	}
+
+	var segments []string
+	for _, seg := range strings.Split(parsed.Path, "/") {
+		if seg != "" {
+			segments = append(segments, seg)
+		}
+	}
+	segment := func(i int) string {
+		if i < len(segments) {
+			return segments[i]
+		}
+		return ""
+	}
+
+	u := &LinkedInURL{}
+	switch first := segment(0); {
+	case first == "in" && segment(1) != "":
+		name, err := ParseUsername(segment(1))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidURL, raw, err)
+		}
+		u.Kind, u.Username = URLProfile, name
+		u.URL = "https://www.linkedin.com/in/" + url.PathEscape(name.String()) + "/"
+
+	case (first == "company" || first == "showcase") && segment(1) != "":
+		u.Kind, u.CompanyName = URLCompany, segment(1)
+		if id, err := ParseCompanyID(u.CompanyName); err == nil {
+			u.CompanyID = id
+		}
+		u.URL = "https://www.linkedin.com/" + first + "/" + url.PathEscape(u.CompanyName) + "/"
+
+	case first == "jobs":
+		id := parsed.Query().Get("currentJobId")
+		if segment(1) == "view" {
+			id = trailingDigits.FindString(segment(2))
+		}
+		jobID, err := ParseJobID(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q has no job ID", ErrInvalidURL, raw)
+		}
+		u.Kind, u.JobID = URLJob, jobID
+		u.URL = "https://www.linkedin.com/jobs/view/" + jobID.String() + "/"
+
+	case first == "posts" && segment(1) != "":
+		m := activityPattern.FindStringSubmatch(segment(1))
+		if m == nil {
+			return nil, fmt.Errorf("%w: %q has no activity ID", ErrInvalidURL, raw)
+		}
+		postURN, err := ParsePostURN("urn:li:" + m[1] + ":" + m[2])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidURL, raw, err)
+		}
+		u.Kind, u.PostURN = URLPost, postURN
+		u.URL = "https://www.linkedin.com/posts/" + url.PathEscape(segment(1)) + "/"
+
+	case first == "feed" && segment(1) == "update" && segment(2) != "":
+		postURN, err := ParsePostURN(segment(2))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidURL, raw, err)
+		}
+		u.Kind, u.PostURN = URLPost, postURN
+		u.URL = "https://www.linkedin.com/feed/update/" + postURN.URN() + "/"
+
+	case first == "pulse" && segment(1) != "":
+		u.Kind = URLArticle
+		u.URL = "https://www.linkedin.com/pulse/" + url.PathEscape(segment(1)) + "/"
+		u.ArticleURL = u.URL
+
+	case first == "services" && segment(1) == "page" && segment(2) != "":
+		u.Kind, u.ServiceName = URLService, segment(2)
+		u.URL = "https://www.linkedin.com/services/page/" + url.PathEscape(u.ServiceName) + "/"
+
+	default:
+		return nil, fmt.Errorf("%w: unsupported path in %q", ErrInvalidURL, raw)
+	}
+	return u, nil
+}
+
+// Resolve parses a LinkedIn URL and, for profile and company URLs, looks up
+// the identifier the URN- and ID-based endpoints need using the client's
+// Resolver: ProfileURN is filled for profiles and CompanyID for companies.
+// Known identifiers and other kinds cost no request.
+//
+// Example:
+//
+//	u, err := client.Resolve("https://www.linkedin.com/in/ryanroslansky")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	skills, err := client.GetSkills(u.ProfileURN.String())
+func (c *Client) Resolve(raw string) (*LinkedInURL, error) {
+	u, err := ParseLinkedInURL(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case u.Kind == URLProfile:
+		if u.ProfileURN, err = c.resolver.ProfileURN(u.Username.String()); err != nil {
+			return nil, err
+		}
+	case u.Kind == URLCompany && u.CompanyID == "":
+		if u.CompanyID, err = c.resolver.CompanyID(u.CompanyName); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
@@ -0,0 +1,197 @@
+package linkdapi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi"
+	"github.com/linkdAPI/linkdapi-go-sdk/linkdapi/linkdapitest"
+)
+
+func TestParseLinkedInURL(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want linkdapi.LinkedInURL
+	}{
+		{
+			name: "profile",
+			raw:  "https://www.linkedin.com/in/ryanroslansky/?trk=people",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLProfile, URL: "https://www.linkedin.com/in/ryanroslansky/", Username: "ryanroslansky"},
+		},
+		{
+			name: "profile without scheme",
+			raw:  "linkedin.com/in/ryanroslansky",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLProfile, URL: "https://www.linkedin.com/in/ryanroslansky/", Username: "ryanroslansky"},
+		},
+		{
+			name: "profile on a country subdomain",
+			raw:  "https://uk.linkedin.com/in/ryanroslansky/details/skills/",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLProfile, URL: "https://www.linkedin.com/in/ryanroslansky/", Username: "ryanroslansky"},
+		},
+		{
+			name: "company",
+			raw:  "https://www.linkedin.com/company/google/about/",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLCompany, URL: "https://www.linkedin.com/company/google/", CompanyName: "google"},
+		},
+		{
+			name: "company by ID",
+			raw:  "https://www.linkedin.com/company/1441",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLCompany, URL: "https://www.linkedin.com/company/1441/", CompanyName: "1441", CompanyID: "1441"},
+		},
+		{
+			name: "showcase",
+			raw:  "https://www.linkedin.com/showcase/google-cloud/",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLCompany, URL: "https://www.linkedin.com/showcase/google-cloud/", CompanyName: "google-cloud"},
+		},
+		{
+			name: "job view",
+			raw:  "https://www.linkedin.com/jobs/view/4012345678/?refId=abc",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLJob, URL: "https://www.linkedin.com/jobs/view/4012345678/", JobID: "4012345678"},
+		},
+		{
+			name: "job view with slug",
+			raw:  "https://www.linkedin.com/jobs/view/software-engineer-at-google-4012345678",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLJob, URL: "https://www.linkedin.com/jobs/view/4012345678/", JobID: "4012345678"},
+		},
+		{
+			name: "job search with current job",
+			raw:  "https://www.linkedin.com/jobs/search/?currentJobId=4012345678&keywords=go",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLJob, URL: "https://www.linkedin.com/jobs/view/4012345678/", JobID: "4012345678"},
+		},
+		{
+			name: "post activity",
+			raw:  "https://www.linkedin.com/posts/ryanroslansky_ai-activity-7211111111111111111-AbCd/",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLPost, URL: "https://www.linkedin.com/posts/ryanroslansky_ai-activity-7211111111111111111-AbCd/", PostURN: "7211111111111111111"},
+		},
+		{
+			name: "post ugcPost",
+			raw:  "https://www.linkedin.com/posts/ryanroslansky_ai-ugcPost-7211111111111111111-AbCd",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLPost, URL: "https://www.linkedin.com/posts/ryanroslansky_ai-ugcPost-7211111111111111111-AbCd/", PostURN: "urn:li:ugcPost:7211111111111111111"},
+		},
+		{
+			name: "feed update",
+			raw:  "https://www.linkedin.com/feed/update/urn:li:activity:7211111111111111111/",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLPost, URL: "https://www.linkedin.com/feed/update/urn:li:activity:7211111111111111111/", PostURN: "7211111111111111111"},
+		},
+		{
+			name: "article",
+			raw:  "https://www.linkedin.com/pulse/future-work-ryan-roslansky?trk=public",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLArticle, URL: "https://www.linkedin.com/pulse/future-work-ryan-roslansky/", ArticleURL: "https://www.linkedin.com/pulse/future-work-ryan-roslansky/"},
+		},
+		{
+			name: "service page",
+			raw:  "https://www.linkedin.com/services/page/acme-consulting/",
+			want: linkdapi.LinkedInURL{Kind: linkdapi.URLService, URL: "https://www.linkedin.com/services/page/acme-consulting/", ServiceName: "acme-consulting"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := linkdapi.ParseLinkedInURL(tt.raw)
+			if err != nil {
+				t.Fatalf("ParseLinkedInURL(%q): %v", tt.raw, err)
+			}
+			if *got != tt.want {
+				t.Errorf("ParseLinkedInURL(%q) = %+v, want %+v", tt.raw, *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLinkedInURLRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{"empty", ""},
+		{"other host", "https://example.com/in/ryanroslansky"},
+		{"lookalike host", "https://notlinkedin.com/in/ryanroslansky"},
+		{"home page", "https://www.linkedin.com/"},
+		{"profile without username", "https://www.linkedin.com/in/"},
+		{"profile URN as username", "https://www.linkedin.com/in/ACoAAAEkwwAB9KEc2TrQgOLEQ-vzRyZeCDyc6DQ"},
+		{"company without name", "https://www.linkedin.com/company/"},
+		{"job without ID", "https://www.linkedin.com/jobs/search/?keywords=go"},
+		{"post without activity", "https://www.linkedin.com/posts/ryanroslansky_hello"},
+		{"feed update without URN", "https://www.linkedin.com/feed/update/urn:li:activity:abc/"},
+		{"service without page", "https://www.linkedin.com/services/"},
+		{"unsupported path", "https://www.linkedin.com/groups/12345/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := linkdapi.ParseLinkedInURL(tt.raw)
+			if !errors.Is(err, linkdapi.ErrInvalidURL) {
+				t.Errorf("ParseLinkedInURL(%q) = %+v, %v; want ErrInvalidURL", tt.raw, u, err)
+			}
+		})
+	}
+}
+
+func TestClientResolve(t *testing.T) {
+	srv := linkdapitest.NewServer("test_key")
+	defer srv.Close()
+
+	srv.Fixture("api/v1/profile/username-to-urn", map[string]string{"username": "ryanroslansky"}, map[string]any{"urn": testURN})
+	srv.Fixture("api/v1/companies/company/universal-name-to-id", map[string]string{"universalName": "google"}, map[string]any{"id": 1441})
+
+	client := srv.NewClient(nil)
+	defer client.Close()
+
+	tests := []struct {
+		name     string
+		raw      string
+		want     linkdapi.LinkedInURL
+		requests int // Total lookup requests after this case
+	}{
+		{
+			name:     "profile",
+			raw:      "https://www.linkedin.com/in/ryanroslansky/",
+			want:     linkdapi.LinkedInURL{Kind: linkdapi.URLProfile, URL: "https://www.linkedin.com/in/ryanroslansky/", Username: "ryanroslansky", ProfileURN: testURN},
+			requests: 1,
+		},
+		{
+			name:     "company",
+			raw:      "https://www.linkedin.com/company/google/",
+			want:     linkdapi.LinkedInURL{Kind: linkdapi.URLCompany, URL: "https://www.linkedin.com/company/google/", CompanyName: "google", CompanyID: "1441"},
+			requests: 2,
+		},
+		{
+			name:     "company by ID costs no request",
+			raw:      "https://www.linkedin.com/company/1035/",
+			want:     linkdapi.LinkedInURL{Kind: linkdapi.URLCompany, URL: "https://www.linkedin.com/company/1035/", CompanyName: "1035", CompanyID: "1035"},
+			requests: 2,
+		},
+		{
+			name:     "job costs no request",
+			raw:      "https://www.linkedin.com/jobs/view/4012345678/",
+			want:     linkdapi.LinkedInURL{Kind: linkdapi.URLJob, URL: "https://www.linkedin.com/jobs/view/4012345678/", JobID: "4012345678"},
+			requests: 2,
+		},
+		{
+			name:     "cached profile costs no request",
+			raw:      "linkedin.com/in/ryanroslansky",
+			want:     linkdapi.LinkedInURL{Kind: linkdapi.URLProfile, URL: "https://www.linkedin.com/in/ryanroslansky/", Username: "ryanroslansky", ProfileURN: testURN},
+			requests: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.Resolve(tt.raw)
+			if err != nil {
+				t.Fatalf("Resolve(%q): %v", tt.raw, err)
+			}
+			if *got != tt.want {
+				t.Errorf("Resolve(%q) = %+v, want %+v", tt.raw, *got, tt.want)
+			}
+			if n := len(srv.Requests()); n != tt.requests {
+				t.Errorf("requests = %d, want %d", n, tt.requests)
+			}
+		})
+	}
+
+	if _, err := client.Resolve("https://example.com/in/ryanroslansky"); !errors.Is(err, linkdapi.ErrInvalidURL) {
+		t.Errorf("Resolve(example.com) err = %v, want ErrInvalidURL", err)
+	}
+}
@@ -0,0 +1,110 @@
+package linkdapi
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrBudgetExceeded is returned without sending a request once the credits
+// spent by a client reach Config.Budget.
+var ErrBudgetExceeded = errors.New("credit budget exceeded")
+
+// Usage is a snapshot of the calls a client has made and the credits they cost.
+//
+// A call is billable when the API answers it with a 2xx status and costs one
+// credit. The API does not report per-call costs, so endpoints billed at a
+// different rate are not reflected in Credits.
+type Usage struct {
+	Requests  int                      // HTTP requests sent, retries included
+	Billable  int                      // Requests answered with a 2xx status
+	Credits   float64                  // Credits spent, one per billable request
+	Budget    float64                  // Config.Budget, 0 for unlimited
+	Endpoints map[string]EndpointUsage // Usage per endpoint path, e.g. "api/v1/profile/overview"
+}
+
+// EndpointUsage counts the calls made to one endpoint.
+type EndpointUsage struct {
+	Requests int     // HTTP requests sent, retries included
+	Billable int     // Requests answered with a 2xx status
+	Credits  float64 // Credits spent by billable requests
+}
+
+// usageTracker accumulates a client's usage and enforces its budget.
+//
+// Each request reserves its credit before it is sent and settles it when the
+// response arrives, so concurrent calls cannot together overshoot the budget.
+type usageTracker struct {
+	mu       sync.Mutex
+	usage    Usage
+	reserved float64 // Credits of requests in flight
+}
+
+func newUsageTracker(budget float64) *usageTracker {
+	return &usageTracker{usage: Usage{
+		Budget:    budget,
+		Endpoints: make(map[string]EndpointUsage),
+	}}
+}
+
+// begin counts a request to endpoint and reserves its credit, or fails with
+// ErrBudgetExceeded if the credits spent and reserved reach the budget. Every
+// successful begin must be followed by one end.
+func (t *usageTracker) begin(endpoint string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.usage.Budget > 0 && t.usage.Credits+t.reserved >= t.usage.Budget {
+		return fmt.Errorf("%w: spent %v of %v credits", ErrBudgetExceeded, t.usage.Credits, t.usage.Budget)
+	}
+	t.reserved++
+	t.usage.Requests++
+	e := t.usage.Endpoints[endpoint]
+	e.Requests++
+	t.usage.Endpoints[endpoint] = e
+	return nil
+}
+
+// end settles the credit reserved by begin, spending it if the request was
+// billable and releasing it otherwise.
+func (t *usageTracker) end(endpoint string, billable bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.reserved--
+	if !billable {
+		return
+	}
+	t.usage.Billable++
+	t.usage.Credits++
+	e := t.usage.Endpoints[endpoint]
+	e.Billable++
+	e.Credits++
+	t.usage.Endpoints[endpoint] = e
+}
+
+// snapshot returns a copy of the usage.
+func (t *usageTracker) snapshot() Usage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	u := t.usage
+	u.Endpoints = make(map[string]EndpointUsage, len(t.usage.Endpoints))
+	for endpoint, e := range t.usage.Endpoints {
+		u.Endpoints[endpoint] = e
+	}
+	return u
+}
+
+// Usage returns the calls made by the client so far and the credits they cost.
+//
+// Example:
+//
+//	usage := client.Usage()
+//	fmt.Printf("%d billable calls, %.0f credits\n", usage.Billable, usage.Credits)
+//	for endpoint, e := range usage.Endpoints {
+//	    fmt.Printf("%s: %d calls\n", endpoint, e.Billable)
+//	}
+func (c *Client) Usage() Usage {
+	return c.usage.snapshot()
+}
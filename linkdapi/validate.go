@@ -0,0 +1,149 @@
+package linkdapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidParams is matched by every *ValidationError, so callers can test
+// for invalid search parameters with errors.Is.
+var ErrInvalidParams = errors.New("invalid parameters")
+
+// FieldError describes one invalid field of a params struct.
+type FieldError struct {
+	Field   string // Struct field name, e.g. "JobTypes"
+	Value   string // Offending value
+	Message string // What is wrong, e.g. "must be one of full_time, part_time"
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %q %s", e.Field, e.Value, e.Message)
+}
+
+// ValidationError is returned by the params' Validate methods, and by the
+// client before sending a request, listing every invalid field at once.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return "invalid parameters: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns ErrInvalidParams.
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidParams
+}
+
+// validator collects field errors.
+type validator struct {
+	fields []FieldError
+}
+
+func (v *validator) add(field, value, message string) {
+	v.fields = append(v.fields, FieldError{Field: field, Value: value, Message: message})
+}
+
+// err returns a *ValidationError for the collected field errors, or nil.
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
+
+// oneOf checks that value, if set, is one of allowed.
+func oneOf[T ~string](v *validator, field string, value T, allowed []T) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	names := make([]string, len(allowed))
+	for i, a := range allowed {
+		names[i] = string(a)
+	}
+	v.add(field, string(value), "must be one of "+strings.Join(names, ", "))
+}
+
+// eachOneOf checks every element of values with oneOf.
+func eachOneOf[T ~string](v *validator, field string, values []T, allowed []T) {
+	for _, value := range values {
+		if value == "" {
+			v.add(field, "", "must not contain empty values")
+			continue
+		}
+		oneOf(v, field, value, allowed)
+	}
+}
+
+// Validate reports all fields holding values SearchJobs does not accept.
+func (p JobSearchParams) Validate() error {
+	var v validator
+	eachOneOf(&v, "JobTypes", p.JobTypes, jobTypes)
+	eachOneOf(&v, "Experience", p.Experience, experienceLevels)
+	oneOf(&v, "TimePosted", p.TimePosted, timePosted)
+	oneOf(&v, "Salary", p.Salary, salaries)
+	eachOneOf(&v, "WorkArrangement", p.WorkArrangement, workArrangements)
+	checkStart(&v, "Start", p.Start)
+	return v.err()
+}
+
+// Validate reports all fields holding values SearchJobsV2 does not accept.
+func (p JobSearchV2Params) Validate() error {
+	var v validator
+	oneOf(&v, "SortBy", p.SortBy, sortOrders)
+	oneOf(&v, "DatePosted", p.DatePosted, timePostedV2)
+	eachOneOf(&v, "Experience", p.Experience, experienceLevelsV2)
+	eachOneOf(&v, "JobTypes", p.JobTypes, jobTypesV2)
+	eachOneOf(&v, "WorkplaceTypes", p.WorkplaceTypes, workArrangements)
+	oneOf(&v, "Salary", p.Salary, salariesV2)
+	eachOneOf(&v, "Benefits", p.Benefits, benefits)
+	eachOneOf(&v, "Commitments", p.Commitments, commitments)
+	checkStart(&v, "Start", p.Start)
+	checkCount(&v, "Count", p.Count, jobSearchV2Limits)
+	return v.err()
+}
+
+// Validate reports all fields holding values people search does not accept.
+func (p PeopleSearchParams) Validate() error {
+	var v validator
+	checkStart(&v, "Start", p.Start)
+	checkCount(&v, "Count", p.Count, peopleSearchLimits)
+	return v.err()
+}
+
+// Validate reports all fields holding values company search does not accept.
+func (p CompanySearchParams) Validate() error {
+	var v validator
+	eachOneOf(&v, "CompanySize", p.CompanySize, companySizes)
+	checkStart(&v, "Start", p.Start)
+	checkCount(&v, "Count", p.Count, companySearchLimits)
+	return v.err()
+}
+
+// Validate reports all fields holding values service search does not accept.
+func (p ServiceSearchParams) Validate() error {
+	var v validator
+	checkStart(&v, "Start", p.Start)
+	checkCount(&v, "Count", p.Count, serviceSearchLimits)
+	return v.err()
+}
+
+// Validate reports all fields holding values post search does not accept.
+func (p PostSearchParams) Validate() error {
+	var v validator
+	oneOf(&v, "ContentType", p.ContentType, postContentTypes)
+	oneOf(&v, "DatePosted", p.DatePosted, postDatePosted)
+	oneOf(&v, "SortBy", p.SortBy, sortOrders)
+	checkStart(&v, "Start", p.Start)
+	return v.err()
+}